@@ -0,0 +1,340 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+var (
+	// ErrEmpty is the reason of an *AggregateError returned when the input has no elements to aggregate.
+	ErrEmpty = errors.New("input is empty")
+	// ErrOverflow is the reason of an *AggregateError returned when the result does not fit in the output.
+	ErrOverflow = errors.New("result overflows the output")
+)
+
+// AggregateError is returned by aggregation functions like Sum, Mean, Min and Max, and by sampling
+// functions like Sample, when the result cannot be computed for the given input.
+// Func is the name of the function and Err is the reason, either ErrEmpty or ErrOverflow,
+// which can be checked with errors.Is.
+type AggregateError struct {
+	Func string
+	Err  error
+}
+
+func (e *AggregateError) Error() string {
+	return fmt.Sprintf("cannot compute (%s): %s", e.Func, e.Err)
+}
+
+func (e *AggregateError) Unwrap() error {
+	return e.Err
+}
+
+// Sum adds up the elements of in and sets the result in out.
+//
+// Elements can be of any numeric kind, including named types based on them.
+// Integers are added up exactly and an *AggregateError with ErrOverflow is returned if the result
+// does not fit in out. An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers
+//	2. Output should be a reference to a number
+//	3. Output should be a float if elements are floats
+//
+// Validation errors are returned to the caller.
+func Sum(in, out interface{}) error {
+	return sum("Sum", in, out, nil)
+}
+
+// SumBy is like Sum except that keyFn is invoked for each element to get the number to be added up.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func SumBy(in, out, keyFn interface{}) error {
+	return sum("SumBy", in, out, keyFn)
+}
+
+// Mean computes the arithmetic mean of the elements of in and sets it in out.
+// An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers
+//	2. Output should be a reference to a float
+//
+// Validation errors are returned to the caller.
+func Mean(in, out interface{}) error {
+	return mean("Mean", in, out, nil)
+}
+
+// MeanBy is like Mean except that keyFn is invoked for each element to get the number to be averaged.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func MeanBy(in, out, keyFn interface{}) error {
+	return mean("MeanBy", in, out, keyFn)
+}
+
+// Min sets in out the smallest element of in. Elements can be numbers or strings.
+// An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers or strings
+//	2. Output should be a reference to the element type of the input
+//
+// Validation errors are returned to the caller.
+func Min(in, out interface{}) error {
+	return extreme("Min", in, out, nil, false)
+}
+
+// Max sets in out the largest element of in. It is validated like Min.
+func Max(in, out interface{}) error {
+	return extreme("Max", in, out, nil, true)
+}
+
+// MinBy is like Min except that elements are compared by the number or string keyFn returns for them.
+// Elements themselves can be of any type. The first of the smallest elements is set in out.
+// KeyFn is of the form func(T) K, or a path like Get accepts which is resolved against each element.
+func MinBy(in, out, keyFn interface{}) error {
+	return extreme("MinBy", in, out, keyFn, false)
+}
+
+// MaxBy is like Max except that elements are compared by the number or string keyFn returns for them.
+// Elements themselves can be of any type. The first of the largest elements is set in out.
+// KeyFn is of the form func(T) K, or a path like Get accepts which is resolved against each element.
+func MaxBy(in, out, keyFn interface{}) error {
+	return extreme("MaxBy", in, out, keyFn, true)
+}
+
+func sum(name string, in, out, keyFn interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	_, keys, err := aggregateKeys(input, keyFn, isNumber)
+	if err != nil {
+		return err
+	}
+
+	result, err := sumOf(keys, output.Elem().Type())
+	if err != nil {
+		if err == ErrOverflow {
+			return &AggregateError{Func: name, Err: err}
+		}
+		return err
+	}
+	if len(keys) == 0 {
+		return &AggregateError{Func: name, Err: ErrEmpty}
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+func mean(name string, in, out, keyFn interface{}) error {
+	output, err := floatOut(out)
+	if err != nil {
+		return err
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	_, keys, err := aggregateKeys(input, keyFn, isNumber)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return &AggregateError{Func: name, Err: ErrEmpty}
+	}
+
+	total, err := sumOf(keys, reflect.TypeOf(float64(0)))
+	if err != nil {
+		return err
+	}
+	output.SetFloat(total.Float() / float64(len(keys)))
+
+	return nil
+}
+
+func extreme(name string, in, out, keyFn interface{}, largest bool) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	elements, keys, err := aggregateKeys(input, keyFn, isOrdered)
+	if err != nil {
+		return err
+	}
+	if input.Type().Elem() != output.Elem().Type() {
+		return fmt.Errorf("output (%s) has to be of the element type of input (%s)", output.Elem().Type(), input.Type().Elem())
+	}
+	if len(keys) == 0 {
+		return &AggregateError{Func: name, Err: ErrEmpty}
+	}
+
+	selected := 0
+	for i := 1; i < len(keys); i++ {
+		if largest && less(keys[selected], keys[i]) || !largest && less(keys[i], keys[selected]) {
+			selected = i
+		}
+	}
+	output.Elem().Set(elements[selected])
+
+	return nil
+}
+
+// aggregateKeys returns the elements of input along with the keys they are aggregated by,
+// which are the elements themselves if keyFn is nil. Keys should be of a kind that allowed accepts.
+func aggregateKeys(input reflect.Value, keyFn interface{}, allowed func(reflect.Kind) bool) (elements, keys []reflect.Value, err error) {
+	key, err := aggregateKeyFn(input, keyFn, allowed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	eachAggregated(input, key, func(element, key reflect.Value) {
+		elements = append(elements, element)
+		keys = append(keys, key)
+	})
+	return elements, keys, nil
+}
+
+// aggregateKeyFn validates input and keyFn, and returns keyFn as a reflect.Value.
+// If keyFn is a string, it is treated as a path and a key function that returns the value
+// found at that path in the elements of input is built. If keyFn is nil, the returned value is invalid.
+func aggregateKeyFn(input reflect.Value, keyFn interface{}, allowed func(reflect.Kind) bool) (reflect.Value, error) {
+	switch input.Kind() {
+	case reflect.Slice, reflect.Array:
+	case reflect.Chan:
+		if input.Type().ChanDir()&reflect.RecvDir == 0 {
+			return reflect.Value{}, fmt.Errorf("input channel (%s) has to allow receiving", input.Type())
+		}
+	default:
+		return reflect.Value{}, fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+
+	return keyFnFor(input.Type().Elem(), keyFn, allowed)
+}
+
+// keyFnFor validates keyFn for elements of elemType, which is done as for aggregateKeyFn.
+func keyFnFor(elemType reflect.Type, keyFn interface{}, allowed func(reflect.Kind) bool) (reflect.Value, error) {
+	if keyFn == nil {
+		if !allowed(elemType.Kind()) {
+			return reflect.Value{}, fmt.Errorf("input's element type (%s) is not supported", elemType)
+		}
+		return reflect.Value{}, nil
+	}
+
+	key := reflect.ValueOf(keyFn)
+	if path, ok := keyFn.(string); ok {
+		var err error
+		if key, err = propertyMapper(elemType, path); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	if key.Kind() != reflect.Func {
+		return reflect.Value{}, fmt.Errorf("keyFn has to be a function")
+	}
+
+	keyFnType := key.Type()
+	if keyFnType.NumIn() != 1 || keyFnType.In(0) != elemType {
+		return reflect.Value{}, fmt.Errorf("key function has to take only one argument of type (%s)", elemType)
+	}
+	if keyFnType.NumOut() != 1 {
+		return reflect.Value{}, fmt.Errorf("key function should return only one return value")
+	}
+	if !allowed(keyFnType.Out(0).Kind()) {
+		return reflect.Value{}, fmt.Errorf("key function's return type (%s) is not supported", keyFnType.Out(0))
+	}
+	return key, nil
+}
+
+// eachAggregated calls fn with each element of input and its key as returned by key,
+// which is the element itself if key is invalid. Channels are received from until they are closed.
+func eachAggregated(input, key reflect.Value, fn func(element, key reflect.Value)) {
+	visit := func(element reflect.Value) {
+		if key.IsValid() {
+			fn(element, key.Call([]reflect.Value{element})[0])
+			return
+		}
+		fn(element, element)
+	}
+
+	if input.Kind() == reflect.Chan {
+		for {
+			element, ok := input.Recv()
+			if !ok {
+				return
+			}
+			visit(element)
+		}
+	}
+	for i := 0; i < input.Len(); i++ {
+		visit(input.Index(i))
+	}
+}
+
+// sumOf adds up numbers as a value of outputType. Integers are added up exactly and
+// ErrOverflow is returned if the sum does not fit in outputType.
+func sumOf(numbers []reflect.Value, outputType reflect.Type) (reflect.Value, error) {
+	result := reflect.New(outputType).Elem()
+
+	switch kind := outputType.Kind(); {
+	case isSigned(kind) || isUnsigned(kind):
+		total := new(big.Int)
+		for _, number := range numbers {
+			switch {
+			case isSigned(number.Kind()):
+				total.Add(total, big.NewInt(number.Int()))
+			case isUnsigned(number.Kind()):
+				total.Add(total, new(big.Int).SetUint64(number.Uint()))
+			default:
+				return reflect.Value{}, fmt.Errorf("output (%s) has to be a float to add up (%s)", outputType, number.Type())
+			}
+		}
+
+		if isSigned(kind) {
+			if !total.IsInt64() || result.OverflowInt(total.Int64()) {
+				return reflect.Value{}, ErrOverflow
+			}
+			result.SetInt(total.Int64())
+		} else {
+			if !total.IsUint64() || result.OverflowUint(total.Uint64()) {
+				return reflect.Value{}, ErrOverflow
+			}
+			result.SetUint(total.Uint64())
+		}
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		total := 0.0
+		for _, number := range numbers {
+			total += toFloat(number)
+		}
+		if result.OverflowFloat(total) {
+			return reflect.Value{}, ErrOverflow
+		}
+		result.SetFloat(total)
+	default:
+		return reflect.Value{}, fmt.Errorf("output (%s) has to be a number", outputType)
+	}
+
+	return result, nil
+}
+
+// floatOut validates that out is a reference to a float and returns what it points to.
+func floatOut(out interface{}) (reflect.Value, error) {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return reflect.Value{}, err
+	}
+	if kind := output.Elem().Kind(); kind != reflect.Float32 && kind != reflect.Float64 {
+		return reflect.Value{}, fmt.Errorf("output (%s) has to be a float", output.Elem().Type())
+	}
+	return output.Elem(), nil
+}
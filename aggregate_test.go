@@ -0,0 +1,337 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type celsius float64
+
+type employee struct {
+	Name   string
+	Age    int
+	Salary float64
+}
+
+var employees = []employee{
+	{Name: "John", Age: 30, Salary: 100},
+	{Name: "Doe", Age: 25, Salary: 300},
+	{Name: "Jane", Age: 35, Salary: 200},
+}
+
+func TestSum(t *testing.T) {
+	t.Run("should sum numbers", func(t *testing.T) {
+		{
+			var out int
+			err := godash.Sum([]int{1, 2, 3}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 6, out)
+		}
+		{
+			var out celsius
+			err := godash.Sum([2]celsius{1.5, 2.5}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, celsius(4), out)
+		}
+		{
+			var out float64
+			err := godash.Sum([]uint8{200, 100}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 300.0, out)
+		}
+	})
+
+	t.Run("should sum numbers received from a channel", func(t *testing.T) {
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+		close(in)
+		var out int
+
+		err := godash.Sum(in, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 6, out)
+	})
+
+	t.Run("should return error for empty input", func(t *testing.T) {
+		out := 10
+
+		err := godash.Sum([]int{}, &out)
+
+		assert.EqualError(t, err, "cannot compute (Sum): input is empty")
+		assert.True(t, errors.Is(err, godash.ErrEmpty))
+		assert.Equal(t, 10, out)
+
+		assert.EqualError(t, godash.SumBy([]employee{}, &out, "Age"), "cannot compute (SumBy): input is empty")
+	})
+
+	t.Run("should report overflow of the output", func(t *testing.T) {
+		{
+			var out int8
+			err := godash.Sum([]int8{100, 100}, &out)
+			assert.EqualError(t, err, "cannot compute (Sum): result overflows the output")
+
+			var aggregateErr *godash.AggregateError
+			assert.True(t, errors.As(err, &aggregateErr))
+			assert.Equal(t, "Sum", aggregateErr.Func)
+			assert.True(t, errors.Is(err, godash.ErrOverflow))
+		}
+		{
+			var out int16
+			err := godash.Sum([]int8{100, 100}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, int16(200), out)
+		}
+		{
+			var out int64
+			err := godash.Sum([]int64{math.MaxInt64, 1}, &out)
+			assert.True(t, errors.Is(err, godash.ErrOverflow))
+		}
+		{
+			var out uint
+			err := godash.Sum([]int{-1, 2}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, uint(1), out)
+		}
+		{
+			var out uint
+			err := godash.Sum([]int{-2, 1}, &out)
+			assert.True(t, errors.Is(err, godash.ErrOverflow))
+		}
+		{
+			var out float32
+			err := godash.Sum([]float64{math.MaxFloat64}, &out)
+			assert.True(t, errors.Is(err, godash.ErrOverflow))
+		}
+	})
+
+	t.Run("should validate input and output", func(t *testing.T) {
+		var out int
+		{
+			err := godash.Sum(map[string]int{}, &out)
+			assert.EqualError(t, err, "not implemented for (map)")
+		}
+		{
+			err := godash.Sum([]string{"a"}, &out)
+			assert.EqualError(t, err, "input's element type (string) is not supported")
+		}
+		{
+			err := godash.Sum([]float64{1.5}, &out)
+			assert.EqualError(t, err, "output (int) has to be a float to add up (float64)")
+		}
+		{
+			var out string
+			err := godash.Sum([]int{1}, &out)
+			assert.EqualError(t, err, "output (string) has to be a number")
+		}
+		{
+			err := godash.Sum([]int{1}, out)
+			assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+		}
+	})
+}
+
+func TestSumBy(t *testing.T) {
+	t.Run("should sum keys of elements", func(t *testing.T) {
+		var out int
+
+		err := godash.SumBy(employees, &out, func(e employee) int {
+			return e.Age
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 90, out)
+	})
+
+	t.Run("should accept a path as key function", func(t *testing.T) {
+		var out float64
+
+		err := godash.SumBy(employees, &out, "Salary")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 600.0, out)
+	})
+
+	t.Run("should validate key function", func(t *testing.T) {
+		var out int
+		{
+			err := godash.SumBy(employees, &out, 1)
+			assert.EqualError(t, err, "keyFn has to be a function")
+		}
+		{
+			err := godash.SumBy(employees, &out, func(int) int { return 0 })
+			assert.EqualError(t, err, "key function has to take only one argument of type (godash_test.employee)")
+		}
+		{
+			err := godash.SumBy(employees, &out, func(employee) {})
+			assert.EqualError(t, err, "key function should return only one return value")
+		}
+		{
+			err := godash.SumBy(employees, &out, "Name")
+			assert.EqualError(t, err, "key function's return type (string) is not supported")
+		}
+		{
+			err := godash.SumBy(employees, &out, "Unknown")
+			assert.EqualError(t, err, "path (Unknown) at (Unknown): field not found in (godash_test.employee)")
+		}
+	})
+}
+
+func TestMean(t *testing.T) {
+	t.Run("should compute the mean", func(t *testing.T) {
+		{
+			var out float64
+			err := godash.Mean([]int{1, 2, 3, 4}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 2.5, out)
+		}
+		{
+			var out celsius
+			err := godash.Mean([]celsius{20, 30}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, celsius(25), out)
+		}
+		{
+			var out float64
+			err := godash.MeanBy(employees, &out, "Age")
+			assert.NoError(t, err)
+			assert.Equal(t, 30.0, out)
+		}
+	})
+
+	t.Run("should return error for empty input", func(t *testing.T) {
+		var out float64
+
+		err := godash.Mean([]int{}, &out)
+
+		assert.EqualError(t, err, "cannot compute (Mean): input is empty")
+		assert.True(t, errors.Is(err, godash.ErrEmpty))
+	})
+
+	t.Run("should validate output", func(t *testing.T) {
+		var out int
+
+		err := godash.Mean([]int{1}, &out)
+
+		assert.EqualError(t, err, "output (int) has to be a float")
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("should find the smallest and largest elements", func(t *testing.T) {
+		{
+			var min, max int
+			assert.NoError(t, godash.Min([]int{3, 1, 2}, &min))
+			assert.NoError(t, godash.Max([]int{3, 1, 2}, &max))
+			assert.Equal(t, 1, min)
+			assert.Equal(t, 3, max)
+		}
+		{
+			var min, max string
+			assert.NoError(t, godash.Min([]string{"b", "a", "c"}, &min))
+			assert.NoError(t, godash.Max([]string{"b", "a", "c"}, &max))
+			assert.Equal(t, "a", min)
+			assert.Equal(t, "c", max)
+		}
+	})
+
+	t.Run("should find elements with the smallest and largest keys", func(t *testing.T) {
+		var youngest, richest employee
+
+		assert.NoError(t, godash.MinBy(employees, &youngest, "Age"))
+		assert.NoError(t, godash.MaxBy(employees, &richest, func(e employee) float64 {
+			return e.Salary
+		}))
+
+		assert.Equal(t, "Doe", youngest.Name)
+		assert.Equal(t, "Doe", richest.Name)
+	})
+
+	t.Run("should keep the first element among equal keys", func(t *testing.T) {
+		var out employee
+
+		err := godash.MaxBy(employees, &out, func(employee) int { return 1 })
+
+		assert.NoError(t, err)
+		assert.Equal(t, "John", out.Name)
+	})
+
+	t.Run("should return error for empty input", func(t *testing.T) {
+		var out int
+
+		assert.EqualError(t, godash.Min([]int{}, &out), "cannot compute (Min): input is empty")
+		assert.EqualError(t, godash.MaxBy([]employee{}, &employee{}, "Age"), "cannot compute (MaxBy): input is empty")
+	})
+
+	t.Run("should validate input and output", func(t *testing.T) {
+		{
+			var out employee
+			err := godash.Min(employees, &out)
+			assert.EqualError(t, err, "input's element type (godash_test.employee) is not supported")
+		}
+		{
+			var out int64
+			err := godash.Max([]int{1}, &out)
+			assert.EqualError(t, err, "output (int64) has to be of the element type of input (int)")
+		}
+	})
+}
+
+func ExampleSum() {
+	var total int
+
+	_ = godash.Sum([]int{1, 2, 3}, &total)
+
+	fmt.Println(total)
+
+	// Output: 6
+}
+
+func ExampleSumBy() {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{Name: "John", Age: 30}, {Name: "Doe", Age: 20}}
+	var total int
+
+	_ = godash.SumBy(people, &total, "Age")
+
+	fmt.Println(total)
+
+	// Output: 50
+}
+
+func ExampleMean() {
+	var mean float64
+
+	_ = godash.Mean([]int{1, 2, 3, 4}, &mean)
+
+	fmt.Println(mean)
+
+	// Output: 2.5
+}
+
+func ExampleMaxBy() {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{Name: "John", Age: 30}, {Name: "Doe", Age: 20}}
+	var oldest Person
+
+	_ = godash.MaxBy(people, &oldest, func(person Person) int {
+		return person.Age
+	})
+
+	fmt.Println(oldest.Name)
+
+	// Output: John
+}
@@ -1,12 +1,13 @@
 package godash
 
 import (
-	"fmt"
 	"reflect"
 )
 
 // Any checks if predicate returns truthy for any element of collection. Iteration is stopped once predicate returns truthy.
-// Currently, input of type slice is supported
+// Currently, input of type slice is supported, and strings are iterated as runes (or bytes if they are of type Bytes)
+//
+// Instead of a function, predicateFn can also be a Matcher created by Matches or MatchesProperty.
 //
 // Validations:
 //
@@ -16,47 +17,27 @@ import (
 //
 // Validation errors are returned to the caller
 func Any(in, predicateFn interface{}) (bool, error) {
-	var output bool
-	input := reflect.ValueOf(in)
-	predicate := reflect.ValueOf(predicateFn)
-
-	if predicate.Kind() != reflect.Func {
-		return output, fmt.Errorf("predicateFn has to be a function")
-	}
-
-	predicateFnType := predicate.Type()
-	if predicateFnType.NumIn() != 1 {
-		return output, fmt.Errorf("predicate function has to take only one argument")
+	elemType, recv, err := lazyElements(stringElements(reflect.ValueOf(in)))
+	if err != nil {
+		return false, err
 	}
-
-	if predicateFnType.NumOut() != 1 {
-		return output, fmt.Errorf("predicate function should return only one return value")
+	predicate, err := validateAllAnyPredicate(elemType, predicateFn)
+	if err != nil {
+		return false, err
 	}
 
-	if predicateFnType.Out(0).Kind() != reflect.Bool {
-		return output, fmt.Errorf("predicate function should return a boolean value")
-	}
-
-	inputKind := input.Kind()
-	if inputKind == reflect.Slice {
-		inputSliceElemType := input.Type().Elem
-		predicateFnArgType := predicateFnType.In(0)
-		if inputSliceElemType() != predicateFnArgType {
-			return output, fmt.Errorf("predicate function's argument (%s) has to be (%s)", predicateFnArgType, inputSliceElemType())
+	for {
+		element, ok, err := recv()
+		if err != nil {
+			return false, err
 		}
-
-		for i := 0; i < input.Len(); i++ {
-			arg := input.Index(i)
-			returnValue := predicate.Call([]reflect.Value{arg})[0]
-			if returnValue.Bool() {
-				return true, nil
-			}
+		if !ok {
+			return false, nil
+		}
+		if predicate.Call([]reflect.Value{element})[0].Bool() {
+			return true, nil
 		}
-
-		return output, nil
 	}
-
-	return output, fmt.Errorf("not implemented for (%s)", inputKind)
 }
 
 // Some is an alias for Any function
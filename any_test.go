@@ -20,6 +20,10 @@ func TestSomeAndAny(t *testing.T) {
 			_, err := fn(in, "not a func")
 
 			assert.EqualError(t, err, "predicateFn has to be a function")
+
+			_, err = fn(in, nil)
+
+			assert.EqualError(t, err, "predicateFn has to be a function")
 		})
 
 		t.Run(fmt.Sprintf("%s should return err if predicate function do not take exactly one argument", fnName), func(t *testing.T) {
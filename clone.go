@@ -0,0 +1,215 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Cloner can be implemented by types that need to control how they are copied by CloneDeep.
+// Clone should return a deep copy of the receiver, of the same type as the receiver.
+type Cloner interface {
+	Clone() interface{}
+}
+
+var clonerType = reflect.TypeOf((*Cloner)(nil)).Elem()
+
+// CloneOption configures CloneDeep.
+type CloneOption func(*cloneOptions)
+
+type cloneOptions struct {
+	skipUnexported bool
+}
+
+// SkipUnexported makes CloneDeep leave unexported struct fields with their zero value
+// instead of copying them.
+func SkipUnexported() CloneOption {
+	return func(options *cloneOptions) {
+		options.skipUnexported = true
+	}
+}
+
+// CloneDeep recursively copies in and sets the copy in out.
+// Structs, pointers, slices, maps, arrays and interfaces are copied so that no reference is shared with in.
+// Cycles are preserved, ie. a pointer that is reachable twice in in is copied once and reachable twice in out.
+// Channels and functions are shared as they cannot be copied.
+//
+// Values implementing Cloner, either on the value or on a pointer to it, are copied by calling Clone.
+//
+// Unexported struct fields cannot be accessed via reflection and hence are copied as is,
+// ie. pointers in them are shared. Pass SkipUnexported to leave them empty in the copy instead.
+//
+// Validations:
+//
+//	1. Out should be a reference so that it can be set
+//	2. In and out's element should be of the same type
+//	3. Clone of a Cloner should return a value of the same type as the Cloner
+//
+// Validation errors are returned to the caller.
+func CloneDeep(in, out interface{}, opts ...CloneOption) error {
+	input := reflect.ValueOf(in)
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	if !input.IsValid() {
+		output.Elem().Set(reflect.Zero(output.Elem().Type()))
+		return nil
+	}
+	if input.Type() != output.Elem().Type() {
+		return fmt.Errorf("input(%s) and output(%s) should be of the same Type", input.Type(), output.Elem().Type())
+	}
+
+	c := &deepCloner{visited: map[visit]reflect.Value{}}
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+
+	result, err := c.clone(input)
+	if err != nil {
+		return err
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+// visit identifies a reference that has already been copied.
+type visit struct {
+	ptr    uintptr
+	typ    reflect.Type
+	length int
+}
+
+type deepCloner struct {
+	options cloneOptions
+	visited map[visit]reflect.Value
+}
+
+func (c *deepCloner) clone(v reflect.Value) (reflect.Value, error) {
+	if result, ok, err := c.cloneWithCloner(v); ok || err != nil {
+		return result, err
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(v.Type()), nil
+		}
+		key := visit{ptr: v.Pointer(), typ: v.Type()}
+		if result, ok := c.visited[key]; ok {
+			return result, nil
+		}
+		result := reflect.New(v.Type().Elem())
+		c.visited[key] = result
+		elem, err := c.clone(v.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		result.Elem().Set(elem)
+		return result, nil
+	case reflect.Interface:
+		if v.IsNil() {
+			return reflect.Zero(v.Type()), nil
+		}
+		elem, err := c.clone(v.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(elem)
+		return result, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type()), nil
+		}
+		key := visit{ptr: v.Pointer(), typ: v.Type(), length: v.Len()}
+		if result, ok := c.visited[key]; ok {
+			return result, nil
+		}
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		c.visited[key] = result
+		return result, c.cloneElements(v, result)
+	case reflect.Array:
+		result := reflect.New(v.Type()).Elem()
+		return result, c.cloneElements(v, result)
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type()), nil
+		}
+		key := visit{ptr: v.Pointer(), typ: v.Type()}
+		if result, ok := c.visited[key]; ok {
+			return result, nil
+		}
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.visited[key] = result
+		for _, mapKey := range v.MapKeys() {
+			clonedKey, err := c.clone(mapKey)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			clonedValue, err := c.clone(v.MapIndex(mapKey))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			result.SetMapIndex(clonedKey, clonedValue)
+		}
+		return result, nil
+	case reflect.Struct:
+		result := reflect.New(v.Type()).Elem()
+		if !c.options.skipUnexported {
+			result.Set(v)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			field, err := c.clone(v.Field(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			result.Field(i).Set(field)
+		}
+		return result, nil
+	}
+
+	return v, nil
+}
+
+func (c *deepCloner) cloneElements(from, to reflect.Value) error {
+	for i := 0; i < from.Len(); i++ {
+		elem, err := c.clone(from.Index(i))
+		if err != nil {
+			return err
+		}
+		to.Index(i).Set(elem)
+	}
+	return nil
+}
+
+// cloneWithCloner copies v by calling Clone if v or a pointer to v implements Cloner.
+// ok is false if v cannot be copied using Cloner.
+func (c *deepCloner) cloneWithCloner(v reflect.Value) (result reflect.Value, ok bool, err error) {
+	if !v.CanInterface() || v.Kind() == reflect.Interface {
+		return reflect.Value{}, false, nil
+	}
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Map || v.Kind() == reflect.Slice) && v.IsNil() {
+		return reflect.Value{}, false, nil
+	}
+
+	var cloner Cloner
+	switch {
+	case v.Type().Implements(clonerType):
+		cloner = v.Interface().(Cloner)
+	case reflect.PtrTo(v.Type()).Implements(clonerType):
+		cloner = addressableCopy(v).Addr().Interface().(Cloner)
+	default:
+		return reflect.Value{}, false, nil
+	}
+
+	cloned := reflect.ValueOf(cloner.Clone())
+	if !cloned.IsValid() || cloned.Type() != v.Type() {
+		return reflect.Value{}, false, fmt.Errorf("cloner (%s) should return a value of the same type from Clone", v.Type())
+	}
+	return cloned, true, nil
+}
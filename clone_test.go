@@ -0,0 +1,141 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type node struct {
+	Value    int
+	Next     *node
+	Children []*node
+}
+
+type counter struct {
+	Count int
+}
+
+func (c counter) Clone() interface{} {
+	return counter{Count: c.Count + 100}
+}
+
+type badCloner struct{}
+
+func (badCloner) Clone() interface{} {
+	return "not a badCloner"
+}
+
+type resource struct {
+	Name  string
+	Tags  []string
+	state *int
+}
+
+func TestCloneDeep(t *testing.T) {
+	t.Run("should copy nested values without sharing references", func(t *testing.T) {
+		in := map[string][]map[string]int{"key": {{"a": 1}}}
+		var out map[string][]map[string]int
+
+		err := godash.CloneDeep(in, &out)
+		out["key"][0]["a"] = 2
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, in["key"][0]["a"])
+	})
+
+	t.Run("should copy structs, pointers, arrays and interfaces", func(t *testing.T) {
+		type inner struct {
+			Values [2][]int
+			Any    interface{}
+		}
+		in := &inner{Values: [2][]int{{1}, {2}}, Any: []string{"a"}}
+		var out *inner
+
+		err := godash.CloneDeep(in, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, in, out)
+		out.Values[0][0] = 10
+		out.Any.([]string)[0] = "b"
+		assert.Equal(t, 1, in.Values[0][0])
+		assert.Equal(t, []string{"a"}, in.Any)
+	})
+
+	t.Run("should preserve cycles", func(t *testing.T) {
+		in := &node{Value: 1}
+		in.Next = &node{Value: 2, Next: in}
+		in.Children = []*node{in.Next}
+		var out *node
+
+		err := godash.CloneDeep(in, &out)
+
+		assert.NoError(t, err)
+		assert.True(t, in != out)
+		assert.Same(t, out, out.Next.Next)
+		assert.Same(t, out.Next, out.Children[0])
+	})
+
+	t.Run("should use Cloner", func(t *testing.T) {
+		in := []counter{{Count: 1}}
+		var out []counter
+
+		err := godash.CloneDeep(in, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []counter{{Count: 101}}, out)
+	})
+
+	t.Run("should validate value returned by Cloner", func(t *testing.T) {
+		var out []badCloner
+
+		err := godash.CloneDeep([]badCloner{{}}, &out)
+
+		assert.EqualError(t, err, "cloner (godash_test.badCloner) should return a value of the same type from Clone")
+	})
+
+	t.Run("should copy unexported fields as is unless skipped", func(t *testing.T) {
+		state := 1
+		in := resource{Name: "db", Tags: []string{"prod"}, state: &state}
+
+		{
+			var out resource
+			err := godash.CloneDeep(in, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, in, out)
+			assert.Same(t, in.state, out.state)
+		}
+		{
+			var out resource
+			err := godash.CloneDeep(in, &out, godash.SkipUnexported())
+			assert.NoError(t, err)
+			assert.Equal(t, resource{Name: "db", Tags: []string{"prod"}}, out)
+		}
+	})
+
+	t.Run("should validate output", func(t *testing.T) {
+		{
+			var out []string
+			err := godash.CloneDeep([]int{1}, &out)
+			assert.EqualError(t, err, "input([]int) and output([]string) should be of the same Type")
+		}
+		{
+			err := godash.CloneDeep([]int{1}, nil)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+	})
+}
+
+func ExampleCloneDeep() {
+	input := map[string][]int{"primes": {2, 3, 5}}
+	var output map[string][]int
+
+	_ = godash.CloneDeep(input, &output)
+	output["primes"][0] = 7
+
+	fmt.Println(input, output)
+
+	// Output: map[primes:[2 3 5]] map[primes:[7 3 5]]
+}
@@ -11,13 +11,33 @@ func validateOut(output reflect.Value) error {
 		return fmt.Errorf("output is nil. Pass a reference to set output")
 	}
 
-	if output.IsNil() {
-		return fmt.Errorf("output is nil. Pass a reference to set output")
+	switch output.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Chan, reflect.Func:
+		if output.IsNil() {
+			return fmt.Errorf("output is nil. Pass a reference to set output")
+		}
 	}
 
-	if !output.Elem().CanSet() {
+	if output.Kind() != reflect.Ptr || !output.Elem().CanSet() {
 		return fmt.Errorf("cannot set out. Pass a reference to set output")
 	}
 
 	return nil
 }
+
+func isOrdered(kind reflect.Kind) bool {
+	return isNumber(kind) || kind == reflect.String
+}
+
+// less reports whether a is less than b. Both should be of the same ordered kind.
+func less(a, b reflect.Value) bool {
+	switch {
+	case isSigned(a.Kind()):
+		return a.Int() < b.Int()
+	case isUnsigned(a.Kind()):
+		return a.Uint() < b.Uint()
+	case a.Kind() == reflect.String:
+		return a.String() < b.String()
+	}
+	return a.Float() < b.Float()
+}
@@ -0,0 +1,278 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Pipe sets out to a function that calls fns from left to right, passing the results of each function
+// as arguments to the next one. The resulting function takes the arguments of the first function
+// and returns the results of the last one, so it can be passed to Map, Filter and the like.
+//
+// Types are validated when composing, so the resulting function never panics due to type mismatches.
+//
+// Validations:
+//
+//	1. At least one function should be passed
+//	2. Results of each function should be of the same types as the arguments of the next one
+//	3. Out should be a reference to a function taking the arguments of the first function and returning the results of the last one
+//
+// Validation errors are returned to the caller.
+func Pipe(out interface{}, fns ...interface{}) error {
+	return pipe(out, fns, false)
+}
+
+// Compose is like Pipe except that fns are called from right to left, as in f(g(x)) for Compose(&out, f, g).
+func Compose(out interface{}, fns ...interface{}) error {
+	return pipe(out, fns, true)
+}
+
+// Curry sets out to the curried form of fn, which takes the arguments of fn one at a time.
+// For example, a function of type func(A, B, C) R is curried into func(A) func(B) func(C) R.
+// Functions taking fewer than two arguments are not changed.
+//
+// Validations:
+//
+//	1. Fn should be a function
+//	2. Out should be a reference to a function of the curried type of fn
+//
+// Validation errors are returned to the caller.
+func Curry(fn, out interface{}) error {
+	function := reflect.ValueOf(fn)
+	if function.Kind() != reflect.Func {
+		return fmt.Errorf("fn has to be a function")
+	}
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	curriedType := curryType(function.Type(), 0)
+	if !sameSignature(output.Elem().Type(), curriedType) {
+		return fmt.Errorf("out (%s) has to be of type (%s)", output.Elem().Type(), curriedType)
+	}
+	output.Elem().Set(curried(function, output.Elem().Type(), nil))
+
+	return nil
+}
+
+// Partial sets out to a function that calls fn with args followed by the arguments it is called with.
+// For example, binding a B to a function of type func(B, C) R results in a func(C) R.
+//
+// Args are set as is if they are assignable to the type of the argument and numbers are converted
+// if they fit in the type of the argument without overflowing or losing a fraction.
+// Nil can be bound to arguments of types that can be nil. Arguments of variadic functions can be bound
+// only before the variadic argument.
+//
+// Validations:
+//
+//	1. Fn should be a function
+//	2. Args should be assignable to the leading arguments of fn
+//	3. Out should be a reference to a function taking the rest of the arguments of fn and returning its results
+//
+// Validation errors are returned to the caller.
+func Partial(fn, out interface{}, args ...interface{}) error {
+	return partial(fn, out, args, false)
+}
+
+// PartialRight is like Partial except that args are bound to the trailing arguments of fn,
+// so the function set in out calls fn with the arguments it is called with followed by args.
+// Variadic functions are not supported.
+func PartialRight(fn, out interface{}, args ...interface{}) error {
+	return partial(fn, out, args, true)
+}
+
+func pipe(out interface{}, fns []interface{}, fromRight bool) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if len(fns) == 0 {
+		return fmt.Errorf("fns should have at least one function")
+	}
+
+	functions := make([]reflect.Value, len(fns))
+	for i, fn := range fns {
+		index := i
+		if fromRight {
+			index = len(fns) - 1 - i
+		}
+		functions[index] = reflect.ValueOf(fn)
+		if functions[index].Kind() != reflect.Func {
+			return fmt.Errorf("fns[%d] has to be a function", i)
+		}
+	}
+
+	for i := 1; i < len(functions); i++ {
+		previous, next := functions[i-1].Type(), functions[i].Type()
+		if !sameTypes(outTypes(previous), inTypes(next)) {
+			previousIndex, nextIndex := i-1, i
+			if fromRight {
+				previousIndex, nextIndex = len(fns)-i, len(fns)-1-i
+			}
+			return fmt.Errorf("fns[%d] takes %s but fns[%d] returns %s", nextIndex, typeList(inTypes(next)), previousIndex, typeList(outTypes(previous)))
+		}
+	}
+
+	first, last := functions[0].Type(), functions[len(functions)-1].Type()
+	pipedType := reflect.FuncOf(inTypes(first), outTypes(last), first.IsVariadic())
+	if !sameSignature(output.Elem().Type(), pipedType) {
+		return fmt.Errorf("out (%s) has to be of type (%s)", output.Elem().Type(), pipedType)
+	}
+
+	output.Elem().Set(reflect.MakeFunc(output.Elem().Type(), func(args []reflect.Value) []reflect.Value {
+		for _, function := range functions {
+			args = callFn(function, args)
+		}
+		return args
+	}))
+
+	return nil
+}
+
+// curryType returns the curried type of fnType for its arguments from index on.
+func curryType(fnType reflect.Type, index int) reflect.Type {
+	ins := inTypes(fnType)
+	if len(ins)-index <= 1 {
+		return reflect.FuncOf(ins[index:], outTypes(fnType), fnType.IsVariadic())
+	}
+	return reflect.FuncOf(ins[index:index+1], []reflect.Type{curryType(fnType, index+1)}, false)
+}
+
+// curried returns a function of curriedType that binds the next argument of function after bound.
+func curried(function reflect.Value, curriedType reflect.Type, bound []reflect.Value) reflect.Value {
+	return reflect.MakeFunc(curriedType, func(args []reflect.Value) []reflect.Value {
+		all := append(append([]reflect.Value{}, bound...), args...)
+		if len(all) >= function.Type().NumIn() {
+			return callFn(function, all)
+		}
+		return []reflect.Value{curried(function, curriedType.Out(0), all)}
+	})
+}
+
+func partial(fn, out interface{}, args []interface{}, fromRight bool) error {
+	function := reflect.ValueOf(fn)
+	if function.Kind() != reflect.Func {
+		return fmt.Errorf("fn has to be a function")
+	}
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	fnType := function.Type()
+	ins := inTypes(fnType)
+	bindable := len(ins)
+	if fnType.IsVariadic() {
+		if fromRight {
+			return fmt.Errorf("trailing arguments of variadic fn (%s) cannot be bound", fnType)
+		}
+		bindable--
+	}
+	if len(args) > bindable {
+		return fmt.Errorf("fn (%s) can be bound at most %d argument(s) and not %d", fnType, bindable, len(args))
+	}
+
+	offset := 0
+	if fromRight {
+		offset = len(ins) - len(args)
+	}
+	bound := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		value, err := boundValue(arg, ins[offset+i], offset+i)
+		if err != nil {
+			return err
+		}
+		bound[i] = value
+	}
+
+	rest := ins[len(args):]
+	if fromRight {
+		rest = ins[:offset]
+	}
+	partialType := reflect.FuncOf(rest, outTypes(fnType), fnType.IsVariadic())
+	if !sameSignature(output.Elem().Type(), partialType) {
+		return fmt.Errorf("out (%s) has to be of type (%s)", output.Elem().Type(), partialType)
+	}
+
+	output.Elem().Set(reflect.MakeFunc(output.Elem().Type(), func(args []reflect.Value) []reflect.Value {
+		if fromRight {
+			return callFn(function, append(append([]reflect.Value{}, args...), bound...))
+		}
+		return callFn(function, append(append([]reflect.Value{}, bound...), args...))
+	}))
+
+	return nil
+}
+
+// boundValue returns arg as a value of argType to be bound to the argument at index.
+func boundValue(arg interface{}, argType reflect.Type, index int) (reflect.Value, error) {
+	value := reflect.ValueOf(arg)
+	if !value.IsValid() {
+		switch argType.Kind() {
+		case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Chan, reflect.Func:
+			return reflect.Zero(argType), nil
+		}
+		return reflect.Value{}, fmt.Errorf("nil cannot be bound to argument %d of type (%s)", index, argType)
+	}
+
+	result := reflect.New(argType).Elem()
+	switch {
+	case value.Type().AssignableTo(argType):
+		result.Set(value)
+	case isNumber(value.Kind()) && isNumber(argType.Kind()):
+		converted, ok := convertNumber(value, argType)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("value (%v) cannot be bound to argument %d of type (%s) exactly", arg, index, argType)
+		}
+		result.Set(converted)
+	default:
+		return reflect.Value{}, fmt.Errorf("value of type (%s) cannot be bound to argument %d of type (%s)", value.Type(), index, argType)
+	}
+	return result, nil
+}
+
+func inTypes(fnType reflect.Type) []reflect.Type {
+	types := make([]reflect.Type, fnType.NumIn())
+	for i := range types {
+		types[i] = fnType.In(i)
+	}
+	return types
+}
+
+func outTypes(fnType reflect.Type) []reflect.Type {
+	types := make([]reflect.Type, fnType.NumOut())
+	for i := range types {
+		types[i] = fnType.Out(i)
+	}
+	return types
+}
+
+func sameTypes(a, b []reflect.Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameSignature reports whether the function types a and b take and return the same types,
+// which is the case for a named function type and its underlying type.
+func sameSignature(a, b reflect.Type) bool {
+	return a.Kind() == reflect.Func && b.Kind() == reflect.Func && a.IsVariadic() == b.IsVariadic() &&
+		sameTypes(inTypes(a), inTypes(b)) && sameTypes(outTypes(a), outTypes(b))
+}
+
+// typeList formats types like "(int, string)".
+func typeList(types []reflect.Type) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return "(" + strings.Join(names, ", ") + ")"
+}
@@ -0,0 +1,236 @@
+package godash_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestPipe(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	toString := func(n int) string { return strconv.Itoa(n) }
+
+	t.Run("should call functions from left to right", func(t *testing.T) {
+		var doubledString func(int) string
+
+		err := godash.Pipe(&doubledString, double, double, toString)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "12", doubledString(3))
+	})
+
+	t.Run("should call functions from right to left with compose", func(t *testing.T) {
+		var doubledString func(int) string
+
+		err := godash.Compose(&doubledString, toString, double)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "6", doubledString(3))
+	})
+
+	t.Run("should pass multiple results and variadic arguments along", func(t *testing.T) {
+		var parse func(...string) (int, error)
+		join := func(parts ...string) string { return strings.Join(parts, "") }
+
+		err := godash.Pipe(&parse, join, strconv.Atoi)
+
+		assert.NoError(t, err)
+		n, err := parse("4", "2")
+		assert.NoError(t, err)
+		assert.Equal(t, 42, n)
+	})
+
+	t.Run("should produce functions accepted by Map and Filter", func(t *testing.T) {
+		var mapper func(int) string
+		var isEven func(int) bool
+		_ = godash.Pipe(&mapper, double, toString)
+		_ = godash.Pipe(&isEven, func(n int) int { return n % 2 }, func(n int) bool { return n == 0 })
+		var evens []int
+		var out []string
+
+		assert.NoError(t, godash.Filter([]int{1, 2, 3, 4}, &evens, isEven))
+		assert.NoError(t, godash.Map(evens, &out, mapper))
+
+		assert.Equal(t, []string{"4", "8"}, out)
+	})
+
+	t.Run("should validate functions and output", func(t *testing.T) {
+		var out func(int) string
+		{
+			err := godash.Pipe(&out)
+			assert.EqualError(t, err, "fns should have at least one function")
+		}
+		{
+			err := godash.Pipe(&out, double, "toString")
+			assert.EqualError(t, err, "fns[1] has to be a function")
+		}
+		{
+			err := godash.Pipe(&out, toString, double)
+			assert.EqualError(t, err, "fns[1] takes (int) but fns[0] returns (string)")
+		}
+		{
+			err := godash.Compose(&out, double, toString)
+			assert.EqualError(t, err, "fns[0] takes (int) but fns[1] returns (string)")
+		}
+		{
+			err := godash.Pipe(&out, double)
+			assert.EqualError(t, err, "out (func(int) string) has to be of type (func(int) int)")
+		}
+		{
+			err := godash.Pipe(out, double)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+	})
+}
+
+func TestCurry(t *testing.T) {
+	t.Run("should take arguments one at a time", func(t *testing.T) {
+		var volume func(int) func(int) func(int) int
+
+		err := godash.Curry(func(l, b, h int) int { return l * b * h }, &volume)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 24, volume(2)(3)(4))
+
+		byTwo := volume(2)
+		assert.Equal(t, 6, byTwo(3)(1))
+		assert.Equal(t, 8, byTwo(1)(4))
+	})
+
+	t.Run("should keep the last argument variadic", func(t *testing.T) {
+		joinWith := func(sep int, parts ...string) string { return strings.Join(parts, strconv.Itoa(sep)) }
+		{
+			var join func(int) func(...string) string
+			err := godash.Curry(joinWith, &join)
+			assert.NoError(t, err)
+			assert.Equal(t, "a0b", join(0)("a", "b"))
+		}
+		{
+			var join func(int) func([]string) string
+			err := godash.Curry(joinWith, &join)
+			assert.EqualError(t, err, "out (func(int) func([]string) string) has to be of type (func(int) func(...string) string)")
+		}
+	})
+
+	t.Run("should validate fn", func(t *testing.T) {
+		var out func()
+
+		err := godash.Curry(1, &out)
+
+		assert.EqualError(t, err, "fn has to be a function")
+	})
+}
+
+func TestPartial(t *testing.T) {
+	greet := func(greeting, name string, times int) string {
+		return strings.Repeat(greeting+" "+name+"! ", times)
+	}
+
+	t.Run("should bind leading arguments", func(t *testing.T) {
+		var sayHello func(string, int) string
+
+		err := godash.Partial(greet, &sayHello, "Hello")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello John! ", sayHello("John", 1))
+	})
+
+	t.Run("should bind trailing arguments", func(t *testing.T) {
+		var greetTwice func(string, string) string
+
+		err := godash.PartialRight(greet, &greetTwice, int8(2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Hi Jane! Hi Jane! ", greetTwice("Hi", "Jane"))
+	})
+
+	t.Run("should bind arguments before variadic arguments", func(t *testing.T) {
+		var commaJoin func(...string) string
+
+		err := godash.Partial(func(sep string, parts ...string) string {
+			return strings.Join(parts, sep)
+		}, &commaJoin, ",")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "a,b", commaJoin("a", "b"))
+	})
+
+	t.Run("should bind nil to arguments that can be nil", func(t *testing.T) {
+		var length func() int
+
+		err := godash.Partial(func(values []int) int { return len(values) }, &length, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, length())
+	})
+
+	t.Run("should validate arguments and output", func(t *testing.T) {
+		var out func(string, int) string
+		{
+			err := godash.Partial(greet, &out, 1)
+			assert.EqualError(t, err, "value of type (int) cannot be bound to argument 0 of type (string)")
+		}
+		{
+			err := godash.PartialRight(greet, &out, 2.5)
+			assert.EqualError(t, err, "value (2.5) cannot be bound to argument 2 of type (int) exactly")
+		}
+		{
+			err := godash.PartialRight(greet, &out, nil)
+			assert.EqualError(t, err, "nil cannot be bound to argument 2 of type (int)")
+		}
+		{
+			err := godash.Partial(greet, &out, "a", "b", 1, 2)
+			assert.EqualError(t, err, "fn (func(string, string, int) string) can be bound at most 3 argument(s) and not 4")
+		}
+		{
+			err := godash.PartialRight(fmt.Sprint, &out, "a")
+			assert.EqualError(t, err, "trailing arguments of variadic fn (func(...interface {}) string) cannot be bound")
+		}
+		{
+			err := godash.PartialRight(greet, &out, 1)
+			assert.EqualError(t, err, "out (func(string, int) string) has to be of type (func(string, string) string)")
+		}
+	})
+}
+
+func ExamplePipe() {
+	var slugify func(string) string
+	_ = godash.Pipe(&slugify, strings.TrimSpace, strings.ToLower, strings.Fields, func(words []string) string {
+		return strings.Join(words, "-")
+	})
+
+	var slugs []string
+	_ = godash.Map([]string{" Hello World ", "Godash Rocks"}, &slugs, slugify)
+
+	fmt.Println(slugs)
+
+	// Output: [hello-world godash-rocks]
+}
+
+func ExampleCurry() {
+	var add func(int) func(int) int
+	_ = godash.Curry(func(a, b int) int { return a + b }, &add)
+
+	var out []int
+	_ = godash.Map([]int{1, 2, 3}, &out, add(10))
+
+	fmt.Println(out)
+
+	// Output: [11 12 13]
+}
+
+func ExamplePartial() {
+	var hasPrefixGo func(string) bool
+	_ = godash.PartialRight(strings.HasPrefix, &hasPrefixGo, "go")
+
+	var out []string
+	_ = godash.Filter([]string{"godash", "lodash", "golang"}, &out, hasPrefixGo)
+
+	fmt.Println(out)
+
+	// Output: [godash golang]
+}
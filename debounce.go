@@ -0,0 +1,154 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Debounce sets wrapped to a function that delays calling fn until wait has elapsed since it was last called.
+//
+// By default, fn is called once at the end of a burst of calls with the arguments of the last call.
+// With Leading(true), fn is called at the start of a burst instead, and with both Leading(true)
+// and Trailing(true), fn is called at both ends if wrapped was called more than once in the burst.
+// As fn may be called later in its own goroutine, it cannot return any value.
+//
+// Validations:
+//
+//	1. Fn should be a function that does not return any value
+//	2. Wrapped should be a reference to a function of the same type as fn
+//	3. Wait should not be negative
+//
+// Validation errors are returned to the caller.
+func Debounce(fn, wrapped interface{}, wait time.Duration, opts ...FuncOption) error {
+	function, output, err := validateDelayed(fn, wrapped, wait)
+	if err != nil {
+		return err
+	}
+	options := newFuncOptions(opts)
+	leading, trailing := options.isLeading(false), options.isTrailing()
+
+	var mutex sync.Mutex
+	var timer Timer
+	var generation int
+	var pending []reflect.Value
+
+	expire := func(timerGeneration int) {
+		mutex.Lock()
+		if timerGeneration != generation {
+			mutex.Unlock()
+			return
+		}
+		args := pending
+		timer, pending = nil, nil
+		mutex.Unlock()
+
+		if args != nil {
+			callFn(function, args)
+		}
+	}
+
+	output.Set(reflect.MakeFunc(function.Type(), func(args []reflect.Value) []reflect.Value {
+		mutex.Lock()
+		callNow := false
+		if timer == nil {
+			callNow = leading
+			if !leading && trailing {
+				pending = args
+			}
+		} else {
+			timer.Stop()
+			if trailing {
+				pending = args
+			}
+		}
+		generation++
+		timerGeneration := generation
+		timer = options.clock.AfterFunc(wait, func() { expire(timerGeneration) })
+		mutex.Unlock()
+
+		if callNow {
+			callFn(function, args)
+		}
+		return nil
+	}))
+
+	return nil
+}
+
+// Throttle sets wrapped to a function that calls fn at most once every wait.
+//
+// By default, fn is called at the start of a burst of calls, and then at the end of every wait
+// with the arguments of the last call made during it, if any. Either can be turned off with Leading(false)
+// or Trailing(false). As fn may be called later in its own goroutine, it cannot return any value.
+//
+// Validations:
+//
+//	1. Fn should be a function that does not return any value
+//	2. Wrapped should be a reference to a function of the same type as fn
+//	3. Wait should not be negative
+//
+// Validation errors are returned to the caller.
+func Throttle(fn, wrapped interface{}, wait time.Duration, opts ...FuncOption) error {
+	function, output, err := validateDelayed(fn, wrapped, wait)
+	if err != nil {
+		return err
+	}
+	options := newFuncOptions(opts)
+	leading, trailing := options.isLeading(true), options.isTrailing()
+
+	var mutex sync.Mutex
+	var timer Timer
+	var pending []reflect.Value
+
+	var expire func()
+	expire = func() {
+		mutex.Lock()
+		args := pending
+		pending = nil
+		if args == nil {
+			timer = nil
+			mutex.Unlock()
+			return
+		}
+		timer = options.clock.AfterFunc(wait, expire)
+		mutex.Unlock()
+
+		callFn(function, args)
+	}
+
+	output.Set(reflect.MakeFunc(function.Type(), func(args []reflect.Value) []reflect.Value {
+		mutex.Lock()
+		callNow := false
+		if timer == nil {
+			callNow = leading
+			timer = options.clock.AfterFunc(wait, expire)
+		}
+		if !callNow && trailing {
+			pending = args
+		}
+		mutex.Unlock()
+
+		if callNow {
+			callFn(function, args)
+		}
+		return nil
+	}))
+
+	return nil
+}
+
+func validateDelayed(fn, wrapped interface{}, wait time.Duration) (function, output reflect.Value, err error) {
+	function, output, err = validateWrapped(fn, wrapped)
+	if err != nil {
+		return function, output, err
+	}
+	if function.Type().NumOut() != 0 {
+		return function, output, fmt.Errorf("fn should not return any value as it may be called asynchronously")
+	}
+	if wait < 0 {
+		return function, output, fmt.Errorf("wait (%s) should not be negative", wait)
+	}
+	return function, output, nil
+}
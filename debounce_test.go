@@ -0,0 +1,148 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestDebounce(t *testing.T) {
+	setup := func(opts ...godash.FuncOption) (*fakeClock, *[]string, func(string)) {
+		clock := newFakeClock()
+		var calls []string
+		var save func(string)
+		err := godash.Debounce(func(value string) {
+			calls = append(calls, value)
+		}, &save, 100*time.Millisecond, append(opts, godash.WithClock(clock))...)
+		assert.NoError(t, err)
+		return clock, &calls, save
+	}
+
+	t.Run("should call fn with the last arguments once calls stop", func(t *testing.T) {
+		clock, calls, save := setup()
+
+		save("a")
+		clock.Advance(50 * time.Millisecond)
+		save("ab")
+		clock.Advance(99 * time.Millisecond)
+		assert.Empty(t, *calls)
+
+		clock.Advance(time.Millisecond)
+		assert.Equal(t, []string{"ab"}, *calls)
+
+		save("abc")
+		clock.Advance(time.Second)
+		assert.Equal(t, []string{"ab", "abc"}, *calls)
+	})
+
+	t.Run("should call fn at the start of a burst with leading", func(t *testing.T) {
+		clock, calls, save := setup(godash.Leading(true), godash.Trailing(false))
+
+		save("a")
+		save("ab")
+		assert.Equal(t, []string{"a"}, *calls)
+
+		clock.Advance(100 * time.Millisecond)
+		save("abc")
+		assert.Equal(t, []string{"a", "abc"}, *calls)
+	})
+
+	t.Run("should call fn at both ends with leading and trailing", func(t *testing.T) {
+		clock, calls, save := setup(godash.Leading(true))
+
+		save("a")
+		clock.Advance(100 * time.Millisecond)
+		assert.Equal(t, []string{"a"}, *calls, "trailing call is skipped for a single call")
+
+		save("b")
+		save("bc")
+		clock.Advance(100 * time.Millisecond)
+		assert.Equal(t, []string{"a", "b", "bc"}, *calls)
+	})
+
+	t.Run("should validate fn and wait", func(t *testing.T) {
+		{
+			var wrapped func() int
+			err := godash.Debounce(func() int { return 0 }, &wrapped, time.Second)
+			assert.EqualError(t, err, "fn should not return any value as it may be called asynchronously")
+		}
+		{
+			var wrapped func()
+			err := godash.Debounce(func() {}, &wrapped, -time.Second)
+			assert.EqualError(t, err, "wait (-1s) should not be negative")
+		}
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	setup := func(opts ...godash.FuncOption) (*fakeClock, *[]int, func(int)) {
+		clock := newFakeClock()
+		var calls []int
+		var report func(int)
+		err := godash.Throttle(func(value int) {
+			calls = append(calls, value)
+		}, &report, 100*time.Millisecond, append(opts, godash.WithClock(clock))...)
+		assert.NoError(t, err)
+		return clock, &calls, report
+	}
+
+	t.Run("should call fn at most once every wait", func(t *testing.T) {
+		clock, calls, report := setup()
+
+		for i := 1; i <= 25; i++ {
+			report(i)
+			clock.Advance(10 * time.Millisecond)
+		}
+		clock.Advance(time.Second)
+
+		assert.Equal(t, []int{1, 10, 20, 25}, *calls)
+	})
+
+	t.Run("should start over after a quiet wait", func(t *testing.T) {
+		clock, calls, report := setup()
+
+		report(1)
+		clock.Advance(time.Second)
+		report(2)
+
+		assert.Equal(t, []int{1, 2}, *calls)
+	})
+
+	t.Run("should skip leading or trailing calls", func(t *testing.T) {
+		{
+			clock, calls, report := setup(godash.Leading(false))
+			report(1)
+			report(2)
+			assert.Empty(t, *calls)
+			clock.Advance(100 * time.Millisecond)
+			assert.Equal(t, []int{2}, *calls)
+		}
+		{
+			clock, calls, report := setup(godash.Trailing(false))
+			report(1)
+			report(2)
+			clock.Advance(100 * time.Millisecond)
+			report(3)
+			assert.Equal(t, []int{1, 3}, *calls)
+		}
+	})
+}
+
+func ExampleDebounce() {
+	done := make(chan bool)
+	var search func(string)
+	_ = godash.Debounce(func(query string) {
+		fmt.Println("searching for", query)
+		done <- true
+	}, &search, 10*time.Millisecond)
+
+	search("g")
+	search("go")
+	search("godash")
+	<-done
+
+	// Output: searching for godash
+}
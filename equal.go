@@ -0,0 +1,306 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// EqualOption configures IsEqual and Diff.
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	ignoreUnexported bool
+	nilEqualsEmpty   bool
+	floatTolerance   float64
+	ignoreOrder      bool
+}
+
+// IgnoreUnexported makes IsEqual and Diff skip unexported struct fields.
+func IgnoreUnexported() EqualOption {
+	return func(options *equalOptions) {
+		options.ignoreUnexported = true
+	}
+}
+
+// NilEqualsEmpty makes IsEqual and Diff treat nil slices and maps as equal to empty ones.
+func NilEqualsEmpty() EqualOption {
+	return func(options *equalOptions) {
+		options.nilEqualsEmpty = true
+	}
+}
+
+// FloatTolerance makes IsEqual and Diff treat floats (and complex numbers) as equal
+// if they differ by at most tolerance.
+func FloatTolerance(tolerance float64) EqualOption {
+	return func(options *equalOptions) {
+		options.floatTolerance = tolerance
+	}
+}
+
+// IgnoreOrder makes IsEqual and Diff compare slices and arrays irrespective of the order of their elements.
+func IgnoreOrder() EqualOption {
+	return func(options *equalOptions) {
+		options.ignoreOrder = true
+	}
+}
+
+// Difference is a difference between two values found by Diff.
+// Path is where the values differ, written like the paths accepted by Get, and is empty for the values themselves.
+// Left and Right are the differing values, nil if the value is missing on that side.
+// Values of unexported fields are formatted as strings as they cannot be accessed as is.
+type Difference struct {
+	Path  string
+	Left  interface{}
+	Right interface{}
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %v != %v", d.Path, d.Left, d.Right)
+}
+
+// IsEqual performs a deep comparison between a and b, like reflect.DeepEqual, and reports if they are equal.
+// Pointers are compared by what they point to and cycles are handled.
+//
+// The comparison can be relaxed with IgnoreUnexported, NilEqualsEmpty, FloatTolerance and IgnoreOrder.
+func IsEqual(a, b interface{}, opts ...EqualOption) bool {
+	c := newComparer(opts, true)
+	c.compare("", reflect.ValueOf(a), reflect.ValueOf(b))
+	return len(c.differences) == 0
+}
+
+// Diff performs a deep comparison between a and b like IsEqual and returns all the differences between them.
+// Map keys are visited in sorted order so that the differences are reported in a stable order.
+func Diff(a, b interface{}, opts ...EqualOption) []Difference {
+	c := newComparer(opts, false)
+	c.compare("", reflect.ValueOf(a), reflect.ValueOf(b))
+	return c.differences
+}
+
+type comparison struct {
+	a, b uintptr
+	typ  reflect.Type
+}
+
+type comparer struct {
+	options     equalOptions
+	stopAtFirst bool
+	visited     map[comparison]bool
+	differences []Difference
+}
+
+func newComparer(opts []EqualOption, stopAtFirst bool) *comparer {
+	c := &comparer{stopAtFirst: stopAtFirst, visited: map[comparison]bool{}}
+	for _, opt := range opts {
+		opt(&c.options)
+	}
+	return c
+}
+
+func (c *comparer) done() bool {
+	return c.stopAtFirst && len(c.differences) > 0
+}
+
+func (c *comparer) report(path string, a, b reflect.Value) {
+	c.differences = append(c.differences, Difference{Path: path, Left: valueOf(a), Right: valueOf(b)})
+}
+
+func valueOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if !v.CanInterface() {
+		return fmt.Sprint(v)
+	}
+	return v.Interface()
+}
+
+func (c *comparer) compare(path string, a, b reflect.Value) {
+	if c.done() {
+		return
+	}
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			c.report(path, a, b)
+		}
+		return
+	}
+	if a.Type() != b.Type() {
+		c.report(path, a, b)
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				c.report(path, a, b)
+			}
+			return
+		}
+		if c.seen(a, b) {
+			return
+		}
+		c.compare(path, a.Elem(), b.Elem())
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				c.report(path, a, b)
+			}
+			return
+		}
+		c.compare(path, a.Elem(), b.Elem())
+	case reflect.Slice:
+		if c.nilMismatch(path, a, b) || c.seen(a, b) {
+			return
+		}
+		c.compareElements(path, a, b)
+	case reflect.Array:
+		c.compareElements(path, a, b)
+	case reflect.Map:
+		if c.nilMismatch(path, a, b) || c.seen(a, b) {
+			return
+		}
+		c.compareMaps(path, a, b)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if c.options.ignoreUnexported && field.PkgPath != "" {
+				continue
+			}
+			c.compare(joinPath(path, field.Name), a.Field(i), b.Field(i))
+		}
+	case reflect.Func:
+		if !a.IsNil() || !b.IsNil() {
+			c.report(path, a, b)
+		}
+	default:
+		if !c.scalarsEqual(a, b) {
+			c.report(path, a, b)
+		}
+	}
+}
+
+// seen records that a and b are being compared and reports if they already were,
+// which is the case for cyclic values.
+func (c *comparer) seen(a, b reflect.Value) bool {
+	key := comparison{a: a.Pointer(), b: b.Pointer(), typ: a.Type()}
+	if c.visited[key] {
+		return true
+	}
+	c.visited[key] = true
+	return false
+}
+
+// nilMismatch reports a difference if only one of a and b is nil and returns true if either is nil.
+func (c *comparer) nilMismatch(path string, a, b reflect.Value) bool {
+	if !a.IsNil() && !b.IsNil() {
+		return false
+	}
+	if a.IsNil() != b.IsNil() && !(c.options.nilEqualsEmpty && a.Len() == 0 && b.Len() == 0) {
+		c.report(path, a, b)
+	}
+	return true
+}
+
+func (c *comparer) compareElements(path string, a, b reflect.Value) {
+	if c.options.ignoreOrder {
+		c.compareUnordered(path, a, b)
+		return
+	}
+
+	for i := 0; i < a.Len() || i < b.Len(); i++ {
+		elementPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= b.Len():
+			c.report(elementPath, a.Index(i), reflect.Value{})
+		case i >= a.Len():
+			c.report(elementPath, reflect.Value{}, b.Index(i))
+		default:
+			c.compare(elementPath, a.Index(i), b.Index(i))
+		}
+		if c.done() {
+			return
+		}
+	}
+}
+
+func (c *comparer) compareUnordered(path string, a, b reflect.Value) {
+	matched := make([]bool, b.Len())
+	for i := 0; i < a.Len(); i++ {
+		found := false
+		for j := 0; j < b.Len() && !found; j++ {
+			if matched[j] {
+				continue
+			}
+			element := &comparer{options: c.options, stopAtFirst: true, visited: map[comparison]bool{}}
+			element.compare("", a.Index(i), b.Index(j))
+			if len(element.differences) == 0 {
+				matched[j], found = true, true
+			}
+		}
+		if !found {
+			c.report(fmt.Sprintf("%s[%d]", path, i), a.Index(i), reflect.Value{})
+		}
+	}
+	for j := range matched {
+		if !matched[j] {
+			c.report(fmt.Sprintf("%s[%d]", path, j), reflect.Value{}, b.Index(j))
+		}
+	}
+}
+
+func (c *comparer) compareMaps(path string, a, b reflect.Value) {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+
+	for _, key := range keys {
+		keyPath := fmt.Sprintf("%s[%v]", path, key)
+		if key.Kind() == reflect.String {
+			keyPath = joinPath(path, key.String())
+		}
+		c.compare(keyPath, a.MapIndex(key), b.MapIndex(key))
+		if c.done() {
+			return
+		}
+	}
+}
+
+func (c *comparer) scalarsEqual(a, b reflect.Value) bool {
+	switch {
+	case isSigned(a.Kind()):
+		return a.Int() == b.Int()
+	case isUnsigned(a.Kind()):
+		return a.Uint() == b.Uint()
+	}
+
+	switch a.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float() || math.Abs(a.Float()-b.Float()) <= c.options.floatTolerance
+	case reflect.Complex64, reflect.Complex128:
+		x, y := a.Complex(), b.Complex()
+		return x == y || math.Abs(real(x)-real(y)) <= c.options.floatTolerance && math.Abs(imag(x)-imag(y)) <= c.options.floatTolerance
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return a.Pointer() == b.Pointer()
+	}
+	return false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,161 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type point struct {
+	X, Y  float64
+	label string
+}
+
+type shape struct {
+	Name   string
+	Points []point
+	Tags   map[string]string
+	Parent *shape
+}
+
+func TestIsEqual(t *testing.T) {
+	t.Run("should compare values deeply", func(t *testing.T) {
+		a := shape{Name: "line", Points: []point{{X: 1}, {Y: 2}}, Tags: map[string]string{"a": "b"}}
+		b := shape{Name: "line", Points: []point{{X: 1}, {Y: 2}}, Tags: map[string]string{"a": "b"}}
+
+		assert.True(t, godash.IsEqual(a, b))
+		assert.True(t, godash.IsEqual(&a, &b))
+
+		b.Tags["a"] = "c"
+		assert.False(t, godash.IsEqual(a, b))
+		assert.False(t, godash.IsEqual(1, int64(1)))
+		assert.True(t, godash.IsEqual(nil, nil))
+		assert.False(t, godash.IsEqual(nil, 0))
+	})
+
+	t.Run("should handle cycles", func(t *testing.T) {
+		a := &shape{Name: "a"}
+		a.Parent = a
+		b := &shape{Name: "a"}
+		b.Parent = b
+
+		assert.True(t, godash.IsEqual(a, b))
+	})
+
+	t.Run("should ignore unexported fields", func(t *testing.T) {
+		a := point{X: 1, label: "a"}
+		b := point{X: 1, label: "b"}
+
+		assert.False(t, godash.IsEqual(a, b))
+		assert.True(t, godash.IsEqual(a, b, godash.IgnoreUnexported()))
+	})
+
+	t.Run("should treat nil and empty as equal", func(t *testing.T) {
+		var nilSlice []int
+		var nilMap map[string]int
+
+		assert.False(t, godash.IsEqual(nilSlice, []int{}))
+		assert.True(t, godash.IsEqual(nilSlice, []int{}, godash.NilEqualsEmpty()))
+		assert.True(t, godash.IsEqual(map[string]int{}, nilMap, godash.NilEqualsEmpty()))
+		assert.False(t, godash.IsEqual(nilSlice, []int{1}, godash.NilEqualsEmpty()))
+	})
+
+	t.Run("should compare floats with tolerance", func(t *testing.T) {
+		a, b := 0.1, 0.2
+
+		assert.False(t, godash.IsEqual(a+b, 0.3))
+		assert.True(t, godash.IsEqual(a+b, 0.3, godash.FloatTolerance(1e-9)))
+		assert.True(t, godash.IsEqual([]point{{X: 1.0001}}, []point{{X: 1}}, godash.FloatTolerance(0.001)))
+	})
+
+	t.Run("should compare slices irrespective of order", func(t *testing.T) {
+		assert.False(t, godash.IsEqual([]int{1, 2, 2}, []int{2, 1, 2}))
+		assert.True(t, godash.IsEqual([]int{1, 2, 2}, []int{2, 1, 2}, godash.IgnoreOrder()))
+		assert.False(t, godash.IsEqual([]int{1, 2, 2}, []int{2, 1, 1}, godash.IgnoreOrder()))
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("should return no differences for equal values", func(t *testing.T) {
+		assert.Empty(t, godash.Diff([]int{1}, []int{1}))
+	})
+
+	t.Run("should report path annotated differences", func(t *testing.T) {
+		a := shape{Name: "line", Points: []point{{X: 1}, {Y: 2}}, Tags: map[string]string{"a": "b", "c": "d"}}
+		b := shape{Name: "square", Points: []point{{X: 1}}, Tags: map[string]string{"a": "x", "e": "f"}, Parent: &shape{}}
+
+		differences := godash.Diff(a, b)
+
+		expected := []godash.Difference{
+			{Path: "Name", Left: "line", Right: "square"},
+			{Path: "Points[1]", Left: point{Y: 2}, Right: nil},
+			{Path: "Tags.a", Left: "b", Right: "x"},
+			{Path: "Tags.c", Left: "d", Right: nil},
+			{Path: "Tags.e", Left: nil, Right: "f"},
+			{Path: "Parent", Left: (*shape)(nil), Right: &shape{}},
+		}
+		assert.Equal(t, expected, differences)
+	})
+
+	t.Run("should report unexported fields as strings", func(t *testing.T) {
+		differences := godash.Diff(point{label: "a"}, point{label: "b"})
+
+		assert.Equal(t, []godash.Difference{{Path: "label", Left: "a", Right: "b"}}, differences)
+	})
+
+	t.Run("should report unmatched elements when ignoring order", func(t *testing.T) {
+		differences := godash.Diff([]int{1, 2, 3}, []int{3, 4, 1}, godash.IgnoreOrder())
+
+		expected := []godash.Difference{
+			{Path: "[1]", Left: 2, Right: nil},
+			{Path: "[1]", Left: nil, Right: 4},
+		}
+		assert.Equal(t, expected, differences)
+	})
+
+	t.Run("should report type mismatches", func(t *testing.T) {
+		a := map[string]interface{}{"port": 80, "hosts": []interface{}{"a"}}
+		b := map[string]interface{}{"port": "80", "hosts": []interface{}{"a"}}
+
+		differences := godash.Diff(a, b)
+
+		assert.Equal(t, []godash.Difference{{Path: "port", Left: 80, Right: "80"}}, differences)
+		assert.Equal(t, "port: 80 != 80", differences[0].String())
+	})
+
+	t.Run("should use index syntax for non string keys", func(t *testing.T) {
+		differences := godash.Diff(map[int]string{1: "a"}, map[int]string{1: "b"})
+
+		assert.Equal(t, []godash.Difference{{Path: "[1]", Left: "a", Right: "b"}}, differences)
+	})
+}
+
+func ExampleDiff() {
+	type person struct {
+		Name string
+		Tags []string
+	}
+	expected := person{Name: "John", Tags: []string{"admin", "dev"}}
+	actual := person{Name: "John", Tags: []string{"admin", "ops"}}
+
+	for _, difference := range godash.Diff(expected, actual) {
+		fmt.Println(difference)
+	}
+
+	// Output: Tags[1]: dev != ops
+}
+
+func ExampleIsEqual() {
+	sum := 0.0
+	for i := 0; i < 10; i++ {
+		sum += 0.1
+	}
+
+	output := godash.IsEqual(sum, 1.0, godash.FloatTolerance(1e-9))
+
+	fmt.Println(output)
+
+	// Output: true
+}
@@ -9,8 +9,13 @@ import (
 //
 // Input of type slice is supported as of now.
 // Output is a slice in which filtered-in elements are stored.
+// Strings are supported as slices of their runes, or of their bytes if they are of type Bytes,
+// and out can then be a string too.
 // PredicateFn function is applied on each element of input to determine to filter or not
 //
+// Instead of a function, predicateFn can also be a Matcher created by Matches or MatchesProperty.
+// Out is replaced with a new slice on every call. Use FilterInto to reuse its backing array instead.
+//
 // Validations:
 //
 //	1. Input and Output's slice should be of same type
@@ -20,36 +25,35 @@ import (
 //
 // Validation errors are returned to the caller.
 func Filter(in, out, predicateFn interface{}) error {
-	input := reflect.ValueOf(in)
-
 	output := reflect.ValueOf(out)
 	if err := validateOut(output); err != nil {
 		return err
 	}
-	if input.Type() != output.Elem().Type() {
-		return fmt.Errorf("input(%s) and output(%s) should be of the same Type", input.Type(), output.Elem().Type())
-	}
 
-	predicate := reflect.ValueOf(predicateFn)
-	if predicate.Type().NumOut() != 1 {
-		return fmt.Errorf("predicate function should return only one return value - a boolean")
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
 	}
-	if predicateType := predicate.Type().Out(0).Kind(); predicateType != reflect.Bool {
-		return fmt.Errorf("predicate function should return only a (boolean) and not a (%s)", predicateType)
+
+	resultType := output.Elem().Type()
+	if input.Kind() == reflect.String {
+		input = stringElements(input)
+		if output.Elem().Kind() == reflect.String {
+			resultType = input.Type()
+		} else if input.Type() != output.Elem().Type() {
+			return fmt.Errorf("output (%s) should be a string or (%s) for input of type string", output.Elem().Type(), input.Type())
+		}
+	} else if input.Type() != output.Elem().Type() {
+		return fmt.Errorf("input(%s) and output(%s) should be of the same Type", input.Type(), output.Elem().Type())
 	}
 
 	if input.Kind() == reflect.Slice {
-		{
-			if input.Type().Elem().Kind() != predicate.Type().In(0).Kind() {
-				return fmt.Errorf(
-					"predicate function's first argument has to be the type (%s) instead of (%s)",
-					input.Type().Elem(),
-					predicate.Type().In(0),
-				)
-			}
+		predicate, err := validatePredicate(input.Type().Elem(), predicateFn)
+		if err != nil {
+			return err
 		}
 
-		result := reflect.MakeSlice(output.Elem().Type(), 0, input.Len())
+		result := reflect.MakeSlice(resultType, 0, input.Len())
 		for i := 0; i < input.Len(); i++ {
 			arg := input.Index(i)
 
@@ -60,7 +64,7 @@ func Filter(in, out, predicateFn interface{}) error {
 				result = reflect.Append(result, arg)
 			}
 		}
-		output.Elem().Set(result)
+		output.Elem().Set(result.Convert(output.Elem().Type()))
 
 		return nil
 	}
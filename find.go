@@ -7,10 +7,13 @@ import (
 
 // Find out elements.
 //
-// Input of type slice is supported as of now.
+// Input of type slice is supported as of now. Strings are supported as slices of their runes,
+// or of their bytes if they are of type Bytes.
 // Output is a elements are matched.
 // PredicateFn function is applied on each element of input to determine to find element until it finds the element
 //
+// Instead of a function, predicateFn can also be a Matcher created by Matches or MatchesProperty.
+//
 // Validations:
 //
 //	1. Input's element type and Output should be of same type
@@ -20,42 +23,33 @@ import (
 //
 // Validation errors are returned to the caller
 func Find(in, out, predicateFn interface{}) error {
-	input := reflect.ValueOf(in)
+	elemType, recv, err := lazyElements(stringElements(reflect.ValueOf(in)))
+	if err != nil {
+		return err
+	}
 	output := reflect.ValueOf(out)
-	inputTypeElem := input.Type().Elem()
-	if inputTypeElem != output.Elem().Type() {
-		return fmt.Errorf("input slice (%s) and output (%s) should be of the same Type", inputTypeElem, output.Elem().Type())
+	if err := validateOut(output); err != nil {
+		return err
 	}
-
-	predicate := reflect.ValueOf(predicateFn)
-	if predicate.Type().NumOut() != 1 {
-		return fmt.Errorf("predicate function should return only one return value - a boolean")
+	if elemType != output.Elem().Type() {
+		return fmt.Errorf("input slice (%s) and output (%s) should be of the same Type", elemType, output.Elem().Type())
 	}
-	if predicateType := predicate.Type().Out(0).Kind(); predicateType != reflect.Bool {
-		return fmt.Errorf("predicate function should return only a (boolean) and not a (%s)", predicateType)
+
+	predicate, err := validatePredicate(elemType, predicateFn)
+	if err != nil {
+		return err
 	}
-	if input.Kind() == reflect.Slice {
-		{
-			if inputTypeElem.Kind() != predicate.Type().In(0).Kind() {
-				return fmt.Errorf(
-					"predicate function's first argument has to be the type (%s) instead of (%s)",
-					inputTypeElem,
-					predicate.Type().In(0),
-				)
-			}
+	for {
+		element, ok, err := recv()
+		if err != nil {
+			return err
 		}
-		for i := 0; i < input.Len(); i++ {
-			arg := input.Index(i)
-
-			returnValues := predicate.Call([]reflect.Value{arg})
-			predicatePassed := returnValues[0].Bool()
-
-			if predicatePassed {
-				output.Elem().Set(arg)
-				return nil
-			}
+		if !ok {
+			return fmt.Errorf("element not found")
+		}
+		if predicate.Call([]reflect.Value{element})[0].Bool() {
+			output.Elem().Set(element)
+			return nil
 		}
-		return fmt.Errorf("element not found")
 	}
-	return fmt.Errorf("not implemented")
 }
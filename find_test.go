@@ -99,6 +99,25 @@ func TestFind(t *testing.T) {
 		}
 	})
 
+	t.Run("should return error instead of panicking for unsupported input and output", func(t *testing.T) {
+		var out int
+		{
+			err := godash.Find(1, &out, func(x int) bool { return true })
+			assert.EqualError(t, err, "not implemented for (int)")
+		}
+		{
+			err := godash.Find(nil, &out, func(x int) bool { return true })
+			assert.EqualError(t, err, "not implemented for (invalid)")
+		}
+		{
+			err := godash.Find([]int{1}, out, func(x int) bool { return true })
+			assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+		}
+		{
+			err := godash.Find([]int{1}, &out, nil)
+			assert.EqualError(t, err, "predicateFn has to be a function")
+		}
+	})
 }
 
 func ExampleFind() {
@@ -0,0 +1,185 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ForEach invokes iterateeFn for each element of in, from left to right.
+// Currently, input of type slice, array, map, string, channel and Iterator is supported.
+//
+// The iteratee can be written in one of three forms:
+//
+//	1. func(T) which is called for every element
+//	2. func(T) bool where returning false stops the iteration early
+//	3. func(T) error where returning a non-nil error stops the iteration and the error is returned to the caller
+//
+// For maps, the iteratee takes the key and the value as two arguments, eg. func(K, V) bool.
+// Strings are iterated rune by rune, and channels and Iterators are read until they are exhausted or the iteration is stopped.
+//
+// Validations:
+//
+//	1. Iteratee function should take one argument (two for maps) and return at most one value
+//	2. Iteratee function's return value, if any, should be a bool or an error
+//	3. Iteratee function's argument should be of the same type as the elements of the input
+//
+// Validation errors are returned to the caller.
+func ForEach(in, iterateeFn interface{}) error {
+	return forEach(in, iterateeFn, false)
+}
+
+// ForEachRight is like ForEach except that it iterates over elements of in from right to left.
+// Channels and Iterators are drained completely before the iteration starts.
+// Since Go does not define an order for maps, ForEachRight on a map behaves like ForEach.
+func ForEachRight(in, iterateeFn interface{}) error {
+	return forEach(in, iterateeFn, true)
+}
+
+// Each is an alias for ForEach function
+func Each(in, iterateeFn interface{}) error {
+	return ForEach(in, iterateeFn)
+}
+
+// EachRight is an alias for ForEachRight function
+func EachRight(in, iterateeFn interface{}) error {
+	return ForEachRight(in, iterateeFn)
+}
+
+func forEach(in, iterateeFn interface{}, fromRight bool) error {
+	input := reflect.ValueOf(in)
+	iteratee := reflect.ValueOf(iterateeFn)
+
+	if iteratee.Kind() != reflect.Func {
+		return fmt.Errorf("iterateeFn has to be a function")
+	}
+
+	iterateeFnType := iteratee.Type()
+	if iterateeFnType.NumOut() > 1 {
+		return fmt.Errorf("iteratee function should return at most one value")
+	}
+	if iterateeFnType.NumOut() == 1 {
+		if returnType := iterateeFnType.Out(0); returnType.Kind() != reflect.Bool && returnType != errorType {
+			return fmt.Errorf("iteratee function should return a (bool) or an (error) and not a (%s)", returnType)
+		}
+	}
+
+	call := func(args ...reflect.Value) (bool, error) {
+		returnValues := iteratee.Call(args)
+		if len(returnValues) == 0 {
+			return true, nil
+		}
+		if returnValues[0].Kind() == reflect.Bool {
+			return returnValues[0].Bool(), nil
+		}
+		if returnValues[0].IsNil() {
+			return true, nil
+		}
+		return false, returnValues[0].Interface().(error)
+	}
+
+	inputKind := input.Kind()
+	switch {
+	case implementsIterator(input), inputKind == reflect.Slice, inputKind == reflect.Array, inputKind == reflect.String, inputKind == reflect.Chan:
+		if iterateeFnType.NumIn() != 1 {
+			return fmt.Errorf("iteratee function has to take only one argument")
+		}
+
+		elements, recv, err := iterableElements(input, iterateeFnType.In(0))
+		if err != nil {
+			return err
+		}
+
+		if recv != nil {
+			for {
+				arg, ok, err := recv()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				if fromRight {
+					elements = reflect.Append(elements, arg)
+					continue
+				}
+				if next, err := call(arg); !next || err != nil {
+					return err
+				}
+			}
+		}
+
+		length := elements.Len()
+		for i := 0; i < length; i++ {
+			index := i
+			if fromRight {
+				index = length - 1 - i
+			}
+			if next, err := call(elements.Index(index)); !next || err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case inputKind == reflect.Map:
+		if iterateeFnType.NumIn() != 2 {
+			return fmt.Errorf("iteratee function has to take exactly two arguments for a map")
+		}
+		if iterateeFnType.In(0) != input.Type().Key() {
+			return fmt.Errorf("iteratee function's first argument (%s) has to be (%s)", iterateeFnType.In(0), input.Type().Key())
+		}
+		if iterateeFnType.In(1) != input.Type().Elem() {
+			return fmt.Errorf("iteratee function's second argument (%s) has to be (%s)", iterateeFnType.In(1), input.Type().Elem())
+		}
+
+		for _, key := range input.MapKeys() {
+			if next, err := call(key, input.MapIndex(key)); !next || err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("not implemented for (%s)", inputKind)
+}
+
+// iterableElements validates that the elements of a slice, array, string, channel or Iterator
+// are of type argType and returns a value that can be indexed to reach them.
+// Strings are converted to a slice of runes. For channels and Iterators an empty slice is
+// returned along with recv, which the caller uses to fill it while draining the input.
+func iterableElements(input reflect.Value, argType reflect.Type) (elements reflect.Value, recv func() (reflect.Value, bool, error), err error) {
+	if iterator, ok := iteratorOf(input); ok {
+		if iterator.elemType != argType {
+			return reflect.Value{}, nil, fmt.Errorf("iteratee function's argument (%s) has to be (%s)", argType, iterator.elemType)
+		}
+		return reflect.MakeSlice(reflect.SliceOf(argType), 0, 0), iterator.recv, nil
+	}
+
+	switch input.Kind() {
+	case reflect.String:
+		runeType := reflect.TypeOf(rune(0))
+		if argType != runeType {
+			return reflect.Value{}, nil, fmt.Errorf("iteratee function's argument (%s) has to be (%s)", argType, runeType)
+		}
+		return reflect.ValueOf([]rune(input.String())), nil, nil
+	case reflect.Chan:
+		if input.Type().ChanDir()&reflect.RecvDir == 0 {
+			return reflect.Value{}, nil, fmt.Errorf("input channel (%s) has to allow receiving", input.Type())
+		}
+		if input.Type().Elem() != argType {
+			return reflect.Value{}, nil, fmt.Errorf("iteratee function's argument (%s) has to be (%s)", argType, input.Type().Elem())
+		}
+		recv = func() (reflect.Value, bool, error) {
+			element, ok := input.Recv()
+			return element, ok, nil
+		}
+		return reflect.MakeSlice(reflect.SliceOf(argType), 0, 0), recv, nil
+	}
+
+	if input.Type().Elem() != argType {
+		return reflect.Value{}, nil, fmt.Errorf("iteratee function's argument (%s) has to be (%s)", argType, input.Type().Elem())
+	}
+	return input, nil, nil
+}
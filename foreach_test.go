@@ -0,0 +1,241 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestForEach(t *testing.T) {
+	t.Run("should call iteratee for each element of a slice", func(t *testing.T) {
+		in := []int{1, 2, 3}
+		var visited []int
+
+		err := godash.ForEach(in, func(el int) {
+			visited = append(visited, el)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, visited)
+	})
+
+	t.Run("should support arrays", func(t *testing.T) {
+		in := [3]string{"a", "b", "c"}
+		var visited []string
+
+		err := godash.ForEach(in, func(el string) {
+			visited = append(visited, el)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, visited)
+	})
+
+	t.Run("should iterate strings rune by rune", func(t *testing.T) {
+		var visited []rune
+
+		err := godash.ForEach("héllo", func(r rune) {
+			visited = append(visited, r)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []rune{'h', 'é', 'l', 'l', 'o'}, visited)
+	})
+
+	t.Run("should read channels until closed", func(t *testing.T) {
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+		close(in)
+		var visited []int
+
+		err := godash.ForEach(in, func(el int) {
+			visited = append(visited, el)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, visited)
+	})
+
+	t.Run("should pass key and value for maps", func(t *testing.T) {
+		in := map[string]int{"key1": 1, "key2": 2}
+		visited := map[string]int{}
+
+		err := godash.ForEach(in, func(key string, value int) {
+			visited[key] = value
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, in, visited)
+	})
+
+	t.Run("should stop iterating when iteratee returns false", func(t *testing.T) {
+		in := []int{1, 2, 3, 4}
+		var visited []int
+
+		err := godash.ForEach(in, func(el int) bool {
+			visited = append(visited, el)
+			return el < 2
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, visited)
+	})
+
+	t.Run("should stop iterating and return the error returned by iteratee", func(t *testing.T) {
+		in := []int{1, 2, 3, 4}
+		var visited []int
+
+		err := godash.ForEach(in, func(el int) error {
+			visited = append(visited, el)
+			if el == 3 {
+				return errors.New("three")
+			}
+			return nil
+		})
+
+		assert.EqualError(t, err, "three")
+		assert.Equal(t, []int{1, 2, 3}, visited)
+	})
+
+	t.Run("should not accept iteratee that is not a function", func(t *testing.T) {
+		err := godash.ForEach([]int{1}, 7)
+
+		assert.EqualError(t, err, "iterateeFn has to be a function")
+	})
+
+	t.Run("should not accept iteratee that do not take exactly one argument", func(t *testing.T) {
+		{
+			err := godash.ForEach([]int{1}, func() {})
+			assert.EqualError(t, err, "iteratee function has to take only one argument")
+		}
+		{
+			err := godash.ForEach([]int{1}, func(int, int) {})
+			assert.EqualError(t, err, "iteratee function has to take only one argument")
+		}
+		{
+			err := godash.ForEach(map[string]int{}, func(int) {})
+			assert.EqualError(t, err, "iteratee function has to take exactly two arguments for a map")
+		}
+	})
+
+	t.Run("should validate iteratee's return values", func(t *testing.T) {
+		{
+			err := godash.ForEach([]int{1}, func(int) (bool, error) { return true, nil })
+			assert.EqualError(t, err, "iteratee function should return at most one value")
+		}
+		{
+			err := godash.ForEach([]int{1}, func(int) int { return 0 })
+			assert.EqualError(t, err, "iteratee function should return a (bool) or an (error) and not a (int)")
+		}
+	})
+
+	t.Run("should validate iteratee's argument type", func(t *testing.T) {
+		{
+			err := godash.ForEach([]int{1}, func(string) {})
+			assert.EqualError(t, err, "iteratee function's argument (string) has to be (int)")
+		}
+		{
+			err := godash.ForEach("abc", func(string) {})
+			assert.EqualError(t, err, "iteratee function's argument (string) has to be (int32)")
+		}
+		{
+			err := godash.ForEach(make(chan int), func(string) {})
+			assert.EqualError(t, err, "iteratee function's argument (string) has to be (int)")
+		}
+		{
+			err := godash.ForEach(map[string]int{}, func(int, int) {})
+			assert.EqualError(t, err, "iteratee function's first argument (int) has to be (string)")
+		}
+		{
+			err := godash.ForEach(map[string]int{}, func(string, string) {})
+			assert.EqualError(t, err, "iteratee function's second argument (string) has to be (int)")
+		}
+	})
+
+	t.Run("should not accept send only channels", func(t *testing.T) {
+		var in chan<- int = make(chan int)
+
+		err := godash.ForEach(in, func(int) {})
+
+		assert.EqualError(t, err, "input channel (chan<- int) has to allow receiving")
+	})
+
+	t.Run("should return err if input is not a collection", func(t *testing.T) {
+		err := godash.ForEach(1, func(int) {})
+
+		assert.EqualError(t, err, "not implemented for (int)")
+	})
+}
+
+func TestForEachRight(t *testing.T) {
+	t.Run("should iterate slices from right to left", func(t *testing.T) {
+		in := []int{1, 2, 3}
+		var visited []int
+
+		err := godash.ForEachRight(in, func(el int) {
+			visited = append(visited, el)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 2, 1}, visited)
+	})
+
+	t.Run("should drain channels and iterate from right to left", func(t *testing.T) {
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+		close(in)
+		var visited []int
+
+		err := godash.ForEachRight(in, func(el int) bool {
+			visited = append(visited, el)
+			return el > 2
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 2}, visited)
+	})
+
+	t.Run("should iterate strings from right to left", func(t *testing.T) {
+		var visited []rune
+
+		err := godash.EachRight("abc", func(r rune) {
+			visited = append(visited, r)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []rune{'c', 'b', 'a'}, visited)
+	})
+}
+
+func ExampleForEach() {
+	input := []string{"rhythm", "of", "life"}
+
+	_ = godash.ForEach(input, func(word string) bool {
+		fmt.Println(word)
+		return word != "of"
+	})
+
+	// Output:
+	// rhythm
+	// of
+}
+
+func ExampleForEachRight() {
+	input := []int{1, 2, 3}
+
+	_ = godash.ForEachRight(input, func(num int) {
+		fmt.Println(num)
+	})
+
+	// Output:
+	// 3
+	// 2
+	// 1
+}
@@ -0,0 +1,168 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for time based functions like Debounce, Throttle, Retry and Memoize with a TTL.
+// It can be replaced with WithClock (or RetryPolicy.Clock), so that tests can control time instead of sleeping.
+type Clock interface {
+	Now() time.Time
+	// Sleep pauses the current goroutine for at least d, like time.Sleep.
+	Sleep(d time.Duration)
+	// AfterFunc calls f in its own goroutine after d has elapsed, like time.AfterFunc.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a timer started by Clock.AfterFunc.
+type Timer interface {
+	// Stop prevents the timer from firing and reports whether it was stopped before it fired, like time.Timer.Stop.
+	Stop() bool
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// FuncOption configures Memoize, Debounce and Throttle.
+type FuncOption func(*funcOptions)
+
+type funcOptions struct {
+	clock       Clock
+	keyResolver interface{}
+	ttl         time.Duration
+	maxSize     int
+	leading     *bool
+	trailing    *bool
+}
+
+func newFuncOptions(opts []FuncOption) funcOptions {
+	options := funcOptions{clock: realClock{}}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// WithClock sets the clock used to tell time. The system clock is used by default.
+func WithClock(clock Clock) FuncOption {
+	return func(options *funcOptions) {
+		options.clock = clock
+	}
+}
+
+// WithKeyResolver sets the function Memoize uses to compute the cache key from the arguments of a call.
+// It takes the same arguments as the memoized function and returns a comparable key.
+func WithKeyResolver(resolverFn interface{}) FuncOption {
+	return func(options *funcOptions) {
+		options.keyResolver = resolverFn
+	}
+}
+
+// WithTTL makes results cached by Memoize expire after ttl. Results do not expire by default.
+func WithTTL(ttl time.Duration) FuncOption {
+	return func(options *funcOptions) {
+		options.ttl = ttl
+	}
+}
+
+// WithMaxSize makes Memoize keep at most size results, evicting the least recently used one.
+// The cache is unbounded by default.
+func WithMaxSize(size int) FuncOption {
+	return func(options *funcOptions) {
+		options.maxSize = size
+	}
+}
+
+// Leading sets whether Debounce and Throttle call the function at the start of a burst of calls.
+// It is false for Debounce and true for Throttle by default.
+func Leading(leading bool) FuncOption {
+	return func(options *funcOptions) {
+		options.leading = &leading
+	}
+}
+
+// Trailing sets whether Debounce and Throttle call the function at the end of a burst of calls.
+// It is true by default.
+func Trailing(trailing bool) FuncOption {
+	return func(options *funcOptions) {
+		options.trailing = &trailing
+	}
+}
+
+func (o funcOptions) isLeading(byDefault bool) bool {
+	if o.leading == nil {
+		return byDefault
+	}
+	return *o.leading
+}
+
+func (o funcOptions) isTrailing() bool {
+	return o.trailing == nil || *o.trailing
+}
+
+// Once sets wrapped to a function that calls fn only the first time it is called.
+// Later calls return the results of the first call without calling fn. It is safe for concurrent use.
+//
+// Validations:
+//
+//	1. Fn should be a function
+//	2. Wrapped should be a reference to a function of the same type as fn
+//
+// Validation errors are returned to the caller.
+func Once(fn, wrapped interface{}) error {
+	function, output, err := validateWrapped(fn, wrapped)
+	if err != nil {
+		return err
+	}
+
+	var once sync.Once
+	var results []reflect.Value
+	output.Set(reflect.MakeFunc(function.Type(), func(args []reflect.Value) []reflect.Value {
+		once.Do(func() {
+			results = callFn(function, args)
+		})
+		return results
+	}))
+
+	return nil
+}
+
+// validateWrapped validates that fn is a function and wrapped is a reference to a function of the same type,
+// and returns fn and what wrapped points to.
+func validateWrapped(fn, wrapped interface{}) (function, output reflect.Value, err error) {
+	function = reflect.ValueOf(fn)
+	if function.Kind() != reflect.Func {
+		return function, output, fmt.Errorf("fn has to be a function")
+	}
+
+	output = reflect.ValueOf(wrapped)
+	if err = validateOut(output); err != nil {
+		return function, output, err
+	}
+	if output.Elem().Type() != function.Type() {
+		return function, output, fmt.Errorf("wrapped (%s) should be of the same Type as fn (%s)", output.Elem().Type(), function.Type())
+	}
+	return function, output.Elem(), nil
+}
+
+// callFn calls function with args, which are as received by a function made by reflect.MakeFunc.
+func callFn(function reflect.Value, args []reflect.Value) []reflect.Value {
+	if function.Type().IsVariadic() {
+		return function.CallSlice(args)
+	}
+	return function.Call(args)
+}
@@ -0,0 +1,168 @@
+package godash_test
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+// fakeClock is a godash.Clock whose time only moves when Advance is called.
+// Timers are fired synchronously by Advance, so tests do not need to sleep.
+type fakeClock struct {
+	sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock   *fakeClock
+	at      time.Time
+	f       func()
+	stopped bool
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.Lock()
+	defer c.Unlock()
+	return c.now
+}
+
+// Sleep returns immediately after advancing the clock by d.
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) godash.Timer {
+	c.Lock()
+	defer c.Unlock()
+	timer := &fakeTimer{clock: c, at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, timer)
+	return timer
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.Lock()
+	defer t.clock.Unlock()
+	wasActive := !t.stopped
+	t.stopped = true
+	return wasActive
+}
+
+// Advance moves the clock forward by d, firing timers that are due in the order of their time.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.Lock()
+	target := c.now.Add(d)
+	c.Unlock()
+
+	for {
+		c.Lock()
+		sort.SliceStable(c.timers, func(i, j int) bool {
+			return c.timers[i].at.Before(c.timers[j].at)
+		})
+		var due *fakeTimer
+		for i, timer := range c.timers {
+			if !timer.stopped && !timer.at.After(target) {
+				due = timer
+				c.timers = append(c.timers[:i], c.timers[i+1:]...)
+				break
+			}
+		}
+		if due == nil {
+			c.now = target
+			c.Unlock()
+			return
+		}
+		due.stopped = true
+		c.now = due.at
+		c.Unlock()
+
+		due.f()
+	}
+}
+
+func TestOnce(t *testing.T) {
+	t.Run("should call fn only once and return its first results", func(t *testing.T) {
+		calls := 0
+		var initialize func(string) (int, error)
+
+		err := godash.Once(func(name string) (int, error) {
+			calls++
+			return len(name), nil
+		}, &initialize)
+		assert.NoError(t, err)
+
+		first, _ := initialize("first")
+		second, _ := initialize("second call")
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 5, first)
+		assert.Equal(t, 5, second)
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		var mutex sync.Mutex
+		calls := 0
+		var increment func()
+		_ = godash.Once(func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			calls++
+		}, &increment)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				increment()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should validate fn and wrapped", func(t *testing.T) {
+		{
+			var wrapped func()
+			err := godash.Once(1, &wrapped)
+			assert.EqualError(t, err, "fn has to be a function")
+		}
+		{
+			var wrapped func(int)
+			err := godash.Once(func() {}, &wrapped)
+			assert.EqualError(t, err, "wrapped (func(int)) should be of the same Type as fn (func())")
+		}
+		{
+			var wrapped func()
+			err := godash.Once(func() {}, wrapped)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+	})
+}
+
+func ExampleOnce() {
+	var setup func() string
+
+	_ = godash.Once(func() string {
+		fmt.Println("setting up")
+		return "ready"
+	}, &setup)
+
+	fmt.Println(setup())
+	fmt.Println(setup())
+
+	// Output:
+	// setting up
+	// ready
+	// ready
+}
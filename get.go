@@ -0,0 +1,103 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Get sets out to the value found at path in obj.
+// Paths are written like "Address.Lines[0]" and are resolved through struct fields (by name or json tag),
+// map keys, slice or array indexes and pointers.
+//
+// If the path does not exist in obj, out is left untouched. So out can be set to a default value before calling Get.
+//
+// Validations:
+//
+//  1. Out should be a reference so that it can be set
+//  2. Path should match the types in obj, eg. an index cannot be used on a struct
+//  3. The value found at path should be assignable to out. Numbers are converted to out's numeric type if they fit exactly
+//
+// Path mismatches are returned as *PathError and validation errors are returned to the caller.
+func Get(obj interface{}, path string, out interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	value, found, err := lookupPath(reflect.ValueOf(obj), path, segments)
+	if err != nil || !found {
+		return err
+	}
+
+	result, err := assignable(value, output.Elem().Type(), path)
+	if err != nil {
+		return err
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+// Has checks if path exists in obj.
+// A path does not exist if a map key or index along the path is missing or a nil pointer is reached.
+//
+// Path mismatches, eg. looking up a field that the struct does not have, are returned as *PathError.
+func Has(obj interface{}, path string) (bool, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return false, err
+	}
+
+	_, found, err := lookupPath(reflect.ValueOf(obj), path, segments)
+	return found, err
+}
+
+// Set sets value at path in obj, which has to be a reference.
+// Nil pointers, maps and slices along the path are allocated, and slices are grown to fit an index.
+// Nil interfaces are filled with a map[string]interface{}, or with a []interface{} if the path continues with an index.
+//
+// Validations:
+//
+//  1. Obj should be a reference so that it can be set
+//  2. Path should match the types in obj
+//  3. Value should be assignable to the type found at path. Numbers are converted to the numeric type at path if they fit exactly
+//
+// Path mismatches are returned as *PathError and validation errors are returned to the caller.
+func Set(obj interface{}, path string, value interface{}) error {
+	return update(obj, path, reflect.ValueOf(value), false)
+}
+
+// Unset removes the value at path in obj, which has to be a reference.
+// Map keys are deleted while struct fields and slice elements are set to their zero value.
+// Nothing is done if the path does not exist in obj.
+func Unset(obj interface{}, path string) error {
+	if found, err := Has(obj, path); !found || err != nil {
+		return err
+	}
+	return update(obj, path, reflect.Value{}, true)
+}
+
+func update(obj interface{}, path string, value reflect.Value, unset bool) error {
+	root := reflect.ValueOf(obj)
+	if root.Kind() != reflect.Ptr || root.IsNil() {
+		return fmt.Errorf("obj has to be a reference to set value at path")
+	}
+
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	updated, err := updatePath(root.Elem(), path, segments, value, unset)
+	if err != nil {
+		return err
+	}
+	root.Elem().Set(updated)
+
+	return nil
+}
@@ -0,0 +1,341 @@
+package godash_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type server struct {
+	Host  string `json:"host"`
+	Ports []int  `json:"ports"`
+}
+
+type config struct {
+	Name    string
+	Server  *server           `json:"server"`
+	Labels  map[string]string `json:"labels"`
+	Servers map[string]server `json:"servers"`
+	Extra   map[string]interface{}
+	private string
+}
+
+func decodedJSON(t *testing.T) map[string]interface{} {
+	var obj map[string]interface{}
+	err := json.Unmarshal([]byte(`{"a": {"b": [{"c": 3}, {"c": "four"}]}}`), &obj)
+	assert.NoError(t, err)
+	return obj
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should get values through struct fields, json tags, map keys and indexes", func(t *testing.T) {
+		in := config{Server: &server{Host: "localhost", Ports: []int{80, 443}}}
+
+		{
+			var out string
+			err := godash.Get(in, "Server.host", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, "localhost", out)
+		}
+		{
+			var out int
+			err := godash.Get(&in, "server.Ports[1]", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 443, out)
+		}
+	})
+
+	t.Run("should get values from decoded json", func(t *testing.T) {
+		in := decodedJSON(t)
+
+		{
+			var out int
+			err := godash.Get(in, "a.b[0].c", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, out)
+		}
+		{
+			var out string
+			err := godash.Get(in, "a.b[1].c", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, "four", out)
+		}
+	})
+
+	t.Run("should leave out untouched if path does not exist", func(t *testing.T) {
+		in := config{Labels: map[string]string{}}
+
+		{
+			out := "default"
+			err := godash.Get(in, "Server.Host", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, "default", out)
+		}
+		{
+			out := "default"
+			err := godash.Get(in, "Labels.env", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, "default", out)
+		}
+		{
+			out := 8080
+			err := godash.Get(decodedJSON(t), "a.b[5].c", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 8080, out)
+		}
+	})
+
+	t.Run("should return path errors on type mismatches", func(t *testing.T) {
+		in := config{Name: "app", Server: &server{}}
+		var out string
+
+		{
+			err := godash.Get(in, "Host", &out)
+			assert.EqualError(t, err, "path (Host) at (Host): field not found in (godash_test.config)")
+		}
+		{
+			err := godash.Get(in, "Name[0]", &out)
+			assert.EqualError(t, err, "path (Name[0]) at ([0]): index cannot be looked up in (string)")
+		}
+		{
+			err := godash.Get(in, "Name.first", &out)
+			assert.EqualError(t, err, "path (Name.first) at (first): field cannot be looked up in (string)")
+		}
+		{
+			var out int
+			err := godash.Get(in, "Name", &out)
+			assert.EqualError(t, err, "path (Name) at (Name): value of type (string) cannot be assigned to (int)")
+
+			var pathErr *godash.PathError
+			assert.True(t, errors.As(err, &pathErr))
+			assert.Equal(t, "Name", pathErr.Segment)
+		}
+		{
+			err := godash.Get(in, "private", &out)
+			assert.EqualError(t, err, "path (private) at (private): field is not exported in (godash_test.config)")
+		}
+	})
+
+	t.Run("should return path errors on numbers that do not fit out exactly", func(t *testing.T) {
+		in := map[string]interface{}{"small": 3.0, "fraction": 3.9, "large": 300, "negative": -1, "huge": 1e300}
+
+		{
+			var out int
+			err := godash.Get(in, "small", &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, out)
+		}
+		{
+			var out int
+			err := godash.Get(in, "fraction", &out)
+			assert.EqualError(t, err, "path (fraction) at (fraction): value (3.9) cannot be converted to (int) exactly")
+			assert.Equal(t, 0, out)
+		}
+		{
+			var out uint8
+			err := godash.Get(in, "large", &out)
+			assert.EqualError(t, err, "path (large) at (large): value (300) cannot be converted to (uint8) exactly")
+
+			err = godash.Get(in, "negative", &out)
+			assert.EqualError(t, err, "path (negative) at (negative): value (-1) cannot be converted to (uint8) exactly")
+		}
+		{
+			var out float32
+			err := godash.Get(in, "huge", &out)
+			assert.EqualError(t, err, "path (huge) at (huge): value (1e+300) cannot be converted to (float32) exactly")
+
+			var integer int64
+			err = godash.Get(in, "huge", &integer)
+			assert.EqualError(t, err, "path (huge) at (huge): value (1e+300) cannot be converted to (int64) exactly")
+		}
+	})
+
+	t.Run("should validate path syntax", func(t *testing.T) {
+		var out string
+
+		{
+			err := godash.Get(config{}, "Servers..Host", &out)
+			assert.EqualError(t, err, "path (Servers..Host) at (): empty segment")
+		}
+		{
+			err := godash.Get(config{}, "Server.Ports[x]", &out)
+			assert.EqualError(t, err, "path (Server.Ports[x]) at ([x]): invalid index")
+		}
+		{
+			err := godash.Get(config{}, "Server.Ports[0", &out)
+			assert.EqualError(t, err, "path (Server.Ports[0) at (Ports[0): invalid segment")
+		}
+	})
+
+	t.Run("should not panic if output is nil", func(t *testing.T) {
+		{
+			err := godash.Get(config{}, "Name", nil)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+		{
+			err := godash.Get(config{}, "Name", "")
+			assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+		}
+	})
+}
+
+func TestHas(t *testing.T) {
+	in := config{Server: &server{Ports: []int{80}}, Labels: map[string]string{"env": "prod"}}
+
+	for path, expected := range map[string]bool{
+		"Server.Ports[0]": true,
+		"Server.Ports[1]": false,
+		"labels.env":      true,
+		"labels.team":     false,
+		"Extra.key":       false,
+	} {
+		found, err := godash.Has(in, path)
+
+		assert.NoError(t, err, path)
+		assert.Equal(t, expected, found, path)
+	}
+
+	_, err := godash.Has(in, "Server.Port")
+	assert.EqualError(t, err, "path (Server.Port) at (Port): field not found in (godash_test.server)")
+}
+
+func TestSet(t *testing.T) {
+	t.Run("should set values allocating pointers, maps and slices on the way", func(t *testing.T) {
+		var in config
+
+		assert.NoError(t, godash.Set(&in, "server.host", "localhost"))
+		assert.NoError(t, godash.Set(&in, "Server.Ports[1]", 443))
+		assert.NoError(t, godash.Set(&in, "Labels.env", "prod"))
+
+		expected := config{
+			Server: &server{Host: "localhost", Ports: []int{0, 443}},
+			Labels: map[string]string{"env": "prod"},
+		}
+		assert.Equal(t, expected, in)
+	})
+
+	t.Run("should set fields of structs stored in maps", func(t *testing.T) {
+		in := config{Servers: map[string]server{"primary": {Host: "localhost"}}}
+
+		err := godash.Set(&in, "servers.primary.ports[0]", 80)
+
+		assert.NoError(t, err)
+		assert.Equal(t, server{Host: "localhost", Ports: []int{80}}, in.Servers["primary"])
+	})
+
+	t.Run("should set values in decoded json creating nested objects", func(t *testing.T) {
+		in := decodedJSON(t)
+
+		assert.NoError(t, godash.Set(&in, "a.b[1].c", 4))
+		assert.NoError(t, godash.Set(&in, "x.y[1]", "z"))
+
+		expected := map[string]interface{}{
+			"a": map[string]interface{}{"b": []interface{}{
+				map[string]interface{}{"c": float64(3)},
+				map[string]interface{}{"c": 4},
+			}},
+			"x": map[string]interface{}{"y": []interface{}{nil, "z"}},
+		}
+		assert.Equal(t, expected, in)
+	})
+
+	t.Run("should convert numbers", func(t *testing.T) {
+		var in server
+
+		err := godash.Set(&in, "Ports[0]", int64(80))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{80}, in.Ports)
+
+		err = godash.Set(&in, "Ports[0]", 80.5)
+		assert.EqualError(t, err, "path (Ports[0]) at ([0]): value (80.5) cannot be converted to (int) exactly")
+		assert.Equal(t, []int{80}, in.Ports)
+	})
+
+	t.Run("should return path errors on type mismatches", func(t *testing.T) {
+		var in config
+
+		{
+			err := godash.Set(&in, "Name", 1)
+			assert.EqualError(t, err, "path (Name) at (Name): value of type (int) cannot be assigned to (string)")
+		}
+		{
+			err := godash.Set(&in, "Server.Host.Name", "x")
+			assert.EqualError(t, err, "path (Server.Host.Name) at (Name): field cannot be looked up in (string)")
+		}
+		{
+			err := godash.Set(&in, "private", "x")
+			assert.EqualError(t, err, "path (private) at (private): field is not exported in (godash_test.config)")
+		}
+	})
+
+	t.Run("should return path errors on fields promoted through nil unexported embedded pointers", func(t *testing.T) {
+		type inner struct {
+			X int
+		}
+		type outer struct {
+			*inner
+		}
+		in := outer{}
+
+		err := godash.Set(&in, "X", 3)
+
+		assert.EqualError(t, err, "path (X) at (X): embedded (*godash_test.inner) is nil and cannot be allocated in (godash_test.outer)")
+		assert.Nil(t, in.inner)
+
+		in.inner = &inner{}
+		assert.NoError(t, godash.Set(&in, "X", 3))
+		assert.Equal(t, 3, in.X)
+	})
+
+	t.Run("should validate that obj is a reference", func(t *testing.T) {
+		err := godash.Set(config{}, "Name", "app")
+
+		assert.EqualError(t, err, "obj has to be a reference to set value at path")
+	})
+}
+
+func TestUnset(t *testing.T) {
+	in := config{Name: "app", Server: &server{Ports: []int{80, 443}}, Labels: map[string]string{"env": "prod"}}
+
+	assert.NoError(t, godash.Unset(&in, "Labels.env"))
+	assert.NoError(t, godash.Unset(&in, "Server.Ports[1]"))
+	assert.NoError(t, godash.Unset(&in, "Name"))
+	assert.NoError(t, godash.Unset(&in, "Extra.missing"))
+
+	expected := config{Server: &server{Ports: []int{80, 0}}, Labels: map[string]string{}}
+	assert.Equal(t, expected, in)
+}
+
+func ExampleGet() {
+	var input map[string]interface{}
+	_ = json.Unmarshal([]byte(`{"users": [{"name": "John"}, {"name": "Doe"}]}`), &input)
+	var output string
+
+	_ = godash.Get(input, "users[1].name", &output)
+
+	fmt.Println(output)
+
+	// Output: Doe
+}
+
+func ExampleSet() {
+	type address struct {
+		City string
+	}
+	type person struct {
+		Name    string
+		Address *address
+	}
+	input := person{Name: "John"}
+
+	_ = godash.Set(&input, "Address.City", "Chennai")
+
+	fmt.Println(input.Address.City)
+
+	// Output: Chennai
+}
@@ -0,0 +1,287 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// SearchOption configures how Includes, IndexOf, LastIndexOf and Count compare elements to a value.
+type SearchOption func(*searchOptions)
+
+type searchOptions struct {
+	comparator   interface{}
+	equalOptions []EqualOption
+	deepEquality bool
+}
+
+// WithComparator makes elements be compared to the value with comparator, which is of the form
+// func(element, value T) bool, where T is the element type of the input.
+func WithComparator(comparator interface{}) SearchOption {
+	return func(options *searchOptions) {
+		options.comparator = comparator
+	}
+}
+
+// WithEqualOptions makes elements be compared to the value with IsEqual and opts, even if they are comparable.
+func WithEqualOptions(opts ...EqualOption) SearchOption {
+	return func(options *searchOptions) {
+		options.equalOptions = opts
+		options.deepEquality = true
+	}
+}
+
+// Includes reports whether value is an element of in.
+//
+// Input can be a slice, an array, a map, whose values are searched, a string or an Iterator.
+// Elements of comparable types are compared to value with ==, and others with IsEqual,
+// as are comparable values whose interface fields hold uncomparable values,
+// unless WithComparator or WithEqualOptions is passed. For strings, value is searched as a substring.
+// Iterators are read only until value is found.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array, a map, a string or an Iterator
+//	2. Value should be assignable to input's element type, or be a string for strings
+//	3. Comparator should take two arguments of input's element type and return a boolean
+//
+// Validation errors are returned to the caller.
+func Includes(in, value interface{}, opts ...SearchOption) (bool, error) {
+	if s, substr, ok, err := substringSearch(in, value); ok || err != nil {
+		return strings.Contains(s, substr), err
+	}
+	m, err := newMatcher(in, value, opts, true)
+	if err != nil {
+		return false, err
+	}
+	for {
+		matched, ok, err := m.next()
+		if !ok || matched {
+			return matched, err
+		}
+	}
+}
+
+// IndexOf returns the index of the first element of in equal to value, or -1 if there is none.
+// It is like Includes, except that maps are not supported as their values are not ordered.
+// For strings, the index of the first rune of the first occurrence of value is returned,
+// as godash treats strings as slices of runes.
+func IndexOf(in, value interface{}, opts ...SearchOption) (int, error) {
+	if s, substr, ok, err := substringSearch(in, value); ok || err != nil {
+		return runeIndex(s, strings.Index(s, substr)), err
+	}
+	m, err := newMatcher(in, value, opts, false)
+	if err != nil {
+		return -1, err
+	}
+	for index := 0; ; index++ {
+		matched, ok, err := m.next()
+		if err != nil {
+			return -1, err
+		}
+		if !ok {
+			return -1, nil
+		}
+		if matched {
+			return index, nil
+		}
+	}
+}
+
+// LastIndexOf is like IndexOf, except that it returns the index of the last element of in equal to value.
+func LastIndexOf(in, value interface{}, opts ...SearchOption) (int, error) {
+	if s, substr, ok, err := substringSearch(in, value); ok || err != nil {
+		return runeIndex(s, strings.LastIndex(s, substr)), err
+	}
+	m, err := newMatcher(in, value, opts, false)
+	if err != nil {
+		return -1, err
+	}
+	last := -1
+	for index := 0; ; index++ {
+		matched, ok, err := m.next()
+		if err != nil {
+			return -1, err
+		}
+		if !ok {
+			return last, nil
+		}
+		if matched {
+			last = index
+		}
+	}
+}
+
+// Count returns the number of elements of in equal to value. It is validated like Includes.
+// For strings, the number of non-overlapping occurrences of value is returned.
+func Count(in, value interface{}, opts ...SearchOption) (int, error) {
+	if s, substr, ok, err := substringSearch(in, value); ok || err != nil {
+		return strings.Count(s, substr), err
+	}
+	m, err := newMatcher(in, value, opts, true)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for {
+		matched, ok, err := m.next()
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return count, nil
+		}
+		if matched {
+			count++
+		}
+	}
+}
+
+// substringSearch returns in and value as strings along with true if in is a string.
+func substringSearch(in, value interface{}) (s, substr string, ok bool, err error) {
+	input := reflect.ValueOf(in)
+	if input.Kind() != reflect.String {
+		return "", "", false, nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return "", "", false, fmt.Errorf("value (%v) has to be a string for input of type (%s)", value, input.Type())
+	}
+	return input.String(), v.String(), true, nil
+}
+
+// runeIndex converts the byte index of s to the index of the rune at it, keeping -1 for not found.
+func runeIndex(s string, index int) int {
+	if index < 0 {
+		return index
+	}
+	return utf8.RuneCountInString(s[:index])
+}
+
+// matcher compares the elements of an input to a value, receiving them one at a time.
+type matcher struct {
+	recv    func() (reflect.Value, bool, error)
+	value   reflect.Value
+	compare func(element, value reflect.Value) bool
+}
+
+func newMatcher(in, value interface{}, opts []SearchOption, maps bool) (matcher, error) {
+	input := reflect.ValueOf(in)
+
+	var elemType reflect.Type
+	var recv func() (reflect.Value, bool, error)
+	if input.Kind() == reflect.Map && maps {
+		var values []reflect.Value
+		_, values, _, elemType, _ = entriesOf(in)
+		recv = indexedElements(len(values), func(i int) reflect.Value { return values[i] })
+	} else {
+		var err error
+		if elemType, recv, err = lazyElements(input); err != nil {
+			return matcher{}, err
+		}
+	}
+
+	v, err := searchValue(value, elemType)
+	if err != nil {
+		return matcher{}, err
+	}
+
+	options := searchOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	compare, err := comparatorFor(elemType, options)
+	if err != nil {
+		return matcher{}, err
+	}
+	return matcher{recv: recv, value: v, compare: compare}, nil
+}
+
+// searchValue validates value can be compared to elements of elemType and returns it as one.
+func searchValue(value interface{}, elemType reflect.Type) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+	switch {
+	case !v.IsValid() && isNillable(elemType.Kind()):
+		return reflect.Zero(elemType), nil
+	case !v.IsValid() || !v.Type().AssignableTo(elemType):
+		return reflect.Value{}, fmt.Errorf("value (%v) has to be of input's element type (%s)", value, elemType)
+	}
+	return v.Convert(elemType), nil
+}
+
+// next receives the next element and reports whether it is equal to the value, and false once there are none.
+func (m matcher) next() (matched, ok bool, err error) {
+	element, ok, err := m.recv()
+	if !ok || err != nil {
+		return false, false, err
+	}
+	return m.compare(element, m.value), true, nil
+}
+
+func comparatorFor(elemType reflect.Type, options searchOptions) (func(element, value reflect.Value) bool, error) {
+	if options.comparator != nil {
+		comparator := reflect.ValueOf(options.comparator)
+		comparatorType := comparator.Type()
+		if comparator.Kind() != reflect.Func {
+			return nil, fmt.Errorf("comparator has to be a function")
+		}
+		if comparatorType.NumIn() != 2 || comparatorType.In(0) != elemType || comparatorType.In(1) != elemType {
+			return nil, fmt.Errorf("comparator has to take two arguments of type (%s)", elemType)
+		}
+		if comparatorType.NumOut() != 1 || comparatorType.Out(0).Kind() != reflect.Bool {
+			return nil, fmt.Errorf("comparator should return only a (boolean)")
+		}
+		return func(element, value reflect.Value) bool {
+			return comparator.Call([]reflect.Value{element, value})[0].Bool()
+		}, nil
+	}
+
+	if options.deepEquality {
+		return func(element, value reflect.Value) bool {
+			return IsEqual(valueOf(element), valueOf(value), options.equalOptions...)
+		}, nil
+	}
+
+	return func(element, value reflect.Value) bool {
+		a, b := valueOf(element), valueOf(value)
+		// == does not panic for values of different types
+		t := reflect.TypeOf(a)
+		if t == nil || t != reflect.TypeOf(b) || strictlyComparable(t) {
+			return a == b
+		}
+		if t.Comparable() {
+			return equalOrDeepEqual(a, b)
+		}
+		return IsEqual(a, b)
+	}, nil
+}
+
+// strictlyComparable reports whether == never panics for values of t,
+// which is not the case for comparable types with interface fields or elements.
+func strictlyComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return strictlyComparable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !strictlyComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return t.Comparable()
+}
+
+// equalOrDeepEqual compares a and b with ==, or with IsEqual if == panics
+// as an interface within them holds an uncomparable value.
+func equalOrDeepEqual(a, b interface{}) (equal bool) {
+	defer func() {
+		if recover() != nil {
+			equal = IsEqual(a, b)
+		}
+	}()
+	return a == b
+}
@@ -0,0 +1,249 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestIncludes(t *testing.T) {
+	t.Run("should report if value is an element of a slice or an array", func(t *testing.T) {
+		found, err := godash.Includes([]int{1, 2, 3}, 2)
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		found, err = godash.Includes([3]string{"a", "b", "c"}, "d")
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("should search the values of maps", func(t *testing.T) {
+		in := map[string]int{"a": 1, "b": 2}
+
+		found, err := godash.Includes(in, 2)
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		found, err = godash.Includes(in, 3)
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("should search substrings of strings", func(t *testing.T) {
+		found, err := godash.Includes("hello world", "o w")
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		found, err = godash.Includes("hello world", "xyz")
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("should compare uncomparable elements deeply", func(t *testing.T) {
+		in := [][]int{{1, 2}, {3}}
+
+		found, err := godash.Includes(in, []int{3})
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		mixed := []interface{}{1, "a", []string{"b"}}
+		found, err = godash.Includes(mixed, []string{"b"})
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		found, err = godash.Includes(mixed, "a")
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should compare deeply if interface fields hold uncomparable values", func(t *testing.T) {
+		type labelled struct {
+			Name  string
+			Value interface{}
+		}
+		in := []labelled{{"a", 1}, {"b", []int{1}}}
+
+		found, err := godash.Includes(in, labelled{"b", []int{1}})
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		found, err = godash.Includes(in, labelled{"a", 1})
+		assert.NoError(t, err)
+		assert.True(t, found)
+
+		found, err = godash.Includes(in, labelled{"b", []int{2}})
+		assert.NoError(t, err)
+		assert.False(t, found)
+
+		nested := []interface{}{[1]interface{}{[]int{1}}}
+		found, err = godash.Includes(nested, [1]interface{}{[]int{1}})
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should compare comparable elements with ==", func(t *testing.T) {
+		first, second := &version{"v1", 1}, &version{"v1", 1}
+
+		found, err := godash.Includes([]*version{first}, second)
+		assert.NoError(t, err)
+		assert.False(t, found)
+
+		found, err = godash.Includes([]*version{first}, second, godash.WithEqualOptions())
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should search nil in slices of a nillable type", func(t *testing.T) {
+		found, err := godash.Includes([]error{errors.New("failed"), nil}, nil)
+
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should accept an iterator", func(t *testing.T) {
+		numbers, _ := godash.RangeIterator(0, 10, 2)
+
+		found, err := godash.Includes(numbers, 6)
+
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should compare with a comparator", func(t *testing.T) {
+		found, err := godash.Includes([]string{"Go", "Rust"}, "go", godash.WithComparator(strings.EqualFold))
+
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should compare with equal options", func(t *testing.T) {
+		found, err := godash.Includes([]float64{0.1, 0.2}, 0.30000000000000004-0.1, godash.WithEqualOptions(godash.FloatTolerance(1e-9)))
+
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should validate input and value", func(t *testing.T) {
+		_, err := godash.Includes(42, 42)
+		assert.EqualError(t, err, "not implemented for (int)")
+
+		_, err = godash.Includes([]int64{1}, 1)
+		assert.EqualError(t, err, "value (1) has to be of input's element type (int64)")
+
+		_, err = godash.Includes([]int{1}, nil)
+		assert.EqualError(t, err, "value (<nil>) has to be of input's element type (int)")
+
+		_, err = godash.Includes("abc", 'a')
+		assert.EqualError(t, err, "value (97) has to be a string for input of type (string)")
+	})
+
+	t.Run("should validate the comparator", func(t *testing.T) {
+		_, err := godash.Includes([]int{1}, 1, godash.WithComparator("=="))
+		assert.EqualError(t, err, "comparator has to be a function")
+
+		_, err = godash.Includes([]int{1}, 1, godash.WithComparator(func(a int) bool { return true }))
+		assert.EqualError(t, err, "comparator has to take two arguments of type (int)")
+
+		_, err = godash.Includes([]int{1}, 1, godash.WithComparator(func(a, b int) int { return 0 }))
+		assert.EqualError(t, err, "comparator should return only a (boolean)")
+	})
+}
+
+func TestIndexOf(t *testing.T) {
+	in := []string{"a", "b", "c", "b"}
+
+	t.Run("should return the index of the first element equal to value", func(t *testing.T) {
+		index, err := godash.IndexOf(in, "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, index)
+
+		index, err = godash.IndexOf(in, "z")
+		assert.NoError(t, err)
+		assert.Equal(t, -1, index)
+	})
+
+	t.Run("should return the rune index of substrings of strings", func(t *testing.T) {
+		index, err := godash.IndexOf("héllo héllo", "llo")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, index)
+
+		index, err = godash.IndexOf("héllo", "x")
+		assert.NoError(t, err)
+		assert.Equal(t, -1, index)
+	})
+
+	t.Run("should not support maps", func(t *testing.T) {
+		_, err := godash.IndexOf(map[string]int{"a": 1}, 1)
+		assert.EqualError(t, err, "not implemented for (map)")
+	})
+}
+
+func TestLastIndexOf(t *testing.T) {
+	t.Run("should return the index of the last element equal to value", func(t *testing.T) {
+		index, err := godash.LastIndexOf([]string{"a", "b", "c", "b"}, "b")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, index)
+
+		index, err = godash.LastIndexOf([]string{}, "b")
+		assert.NoError(t, err)
+		assert.Equal(t, -1, index)
+	})
+
+	t.Run("should return the rune index of the last substring of strings", func(t *testing.T) {
+		index, err := godash.LastIndexOf("héllo héllo", "llo")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 8, index)
+	})
+}
+
+func TestCount(t *testing.T) {
+	t.Run("should count the elements equal to value", func(t *testing.T) {
+		count, err := godash.Count([]int{1, 2, 1, 3, 1}, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		count, err = godash.Count(map[string]string{"a": "x", "b": "y", "c": "x"}, "x")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("should count non-overlapping substrings of strings", func(t *testing.T) {
+		count, err := godash.Count("aaaa", "aa")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("should count with a comparator", func(t *testing.T) {
+		versions := []version{{"v1", 1}, {"v1.1", 1}, {"v2", 2}}
+		sameMajor := func(a, b version) bool { return a.Major == b.Major }
+
+		count, err := godash.Count(versions, version{Major: 1}, godash.WithComparator(sameMajor))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
+
+func ExampleIncludes() {
+	roles := []string{"reader", "writer"}
+
+	canWrite, _ := godash.Includes(roles, "writer")
+	fmt.Println(canWrite)
+
+	// Output: true
+}
+
+func ExampleCount() {
+	statuses := map[string]string{"api": "up", "db": "down", "cache": "up"}
+
+	up, _ := godash.Count(statuses, "up")
+	fmt.Println(up)
+
+	// Output: 2
+}
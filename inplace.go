@@ -0,0 +1,189 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FilterInPlace keeps the elements of the slice slicePtr points to that pass the predicate, in their order,
+// and returns how many are kept.
+//
+// Unlike Filter, no slice is allocated. Kept elements are moved to the front of the backing array,
+// the slice is shortened to them, and the elements after them in the backing array are set to their
+// zero value so that they can be garbage collected. Other slices sharing the backing array see these changes.
+//
+// Instead of a function, predicateFn can also be a Matcher created by Matches or MatchesProperty.
+//
+// Validations:
+//
+//	1. SlicePtr should be a reference to a slice
+//	2. Predicate function should take one argument of the slice's element type and return a boolean
+//
+// Validation errors are returned to the caller.
+func FilterInPlace(slicePtr, predicateFn interface{}) (int, error) {
+	slice, err := inPlaceSlice(slicePtr)
+	if err != nil {
+		return 0, err
+	}
+	predicate, err := validatePredicate(slice.Type().Elem(), predicateFn)
+	if err != nil {
+		return 0, err
+	}
+
+	return retain(slice, func(element reflect.Value) bool {
+		return predicate.Call([]reflect.Value{element})[0].Bool()
+	}), nil
+}
+
+// Remove removes the elements of the slice slicePtr points to that pass the predicate, and returns
+// how many elements are left. It is the opposite of FilterInPlace, and is validated like it.
+func Remove(slicePtr, predicateFn interface{}) (int, error) {
+	slice, err := inPlaceSlice(slicePtr)
+	if err != nil {
+		return 0, err
+	}
+	predicate, err := validatePredicate(slice.Type().Elem(), predicateFn)
+	if err != nil {
+		return 0, err
+	}
+
+	return retain(slice, func(element reflect.Value) bool {
+		return !predicate.Call([]reflect.Value{element})[0].Bool()
+	}), nil
+}
+
+// MapInPlace replaces each element of the slice slicePtr points to with the result of mapperFn on it,
+// and returns the length of the slice. As the results are stored in the slice, mapperFn has to return
+// a value of the slice's element type.
+//
+// Instead of a function, mapperFn can also be a path like Map accepts, if the value at that path is
+// of the slice's element type.
+//
+// Validations:
+//
+//	1. SlicePtr should be a reference to a slice
+//	2. Mapper function should take one argument of the slice's element type and return one of the same type
+//
+// Validation errors are returned to the caller.
+func MapInPlace(slicePtr, mapperFn interface{}) (int, error) {
+	slice, err := inPlaceSlice(slicePtr)
+	if err != nil {
+		return 0, err
+	}
+	mapper, err := mapperValue(slice, mapperFn)
+	if err != nil {
+		return 0, err
+	}
+	if mapper.Kind() != reflect.Func {
+		return 0, fmt.Errorf("mapperFn has to be a function")
+	}
+
+	elemType := slice.Type().Elem()
+	mapperFnType := mapper.Type()
+	if mapperFnType.NumIn() != 1 {
+		return 0, fmt.Errorf("mapper function has to take only one argument")
+	}
+	if mapperFnType.In(0) != elemType {
+		return 0, fmt.Errorf("mapper function's first argument (%s) has to be (%s)", mapperFnType.In(0), elemType)
+	}
+	if mapperFnType.NumOut() != 1 {
+		return 0, fmt.Errorf("mapper function should return only one return value")
+	}
+	if mapperFnType.Out(0) != elemType {
+		return 0, fmt.Errorf("mapper function's return type (%s) has to be (%s)", mapperFnType.Out(0), elemType)
+	}
+
+	for i := 0; i < slice.Len(); i++ {
+		element := slice.Index(i)
+		element.Set(mapper.Call([]reflect.Value{element})[0])
+	}
+	return slice.Len(), nil
+}
+
+// Pull removes the elements of the slice slicePtr points to that are equal to any of values, and returns
+// how many elements are left. Elements are compared to values like Includes does by default.
+//
+// Validations:
+//
+//	1. SlicePtr should be a reference to a slice
+//	2. Values should be assignable to the slice's element type
+//
+// Validation errors are returned to the caller.
+func Pull(slicePtr interface{}, values ...interface{}) (int, error) {
+	slice, err := inPlaceSlice(slicePtr)
+	if err != nil {
+		return 0, err
+	}
+	elemType := slice.Type().Elem()
+	pulled := make([]reflect.Value, len(values))
+	for i, value := range values {
+		if pulled[i], err = searchValue(value, elemType); err != nil {
+			return 0, err
+		}
+	}
+	compare, err := comparatorFor(elemType, searchOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	return retain(slice, func(element reflect.Value) bool {
+		for _, value := range pulled {
+			if compare(element, value) {
+				return false
+			}
+		}
+		return true
+	}), nil
+}
+
+// Compact removes the elements of the slice slicePtr points to that are zero values, like 0, "", false,
+// nil or structs with zero values for all their fields, and returns how many elements are left.
+//
+// Validations:
+//
+//	1. SlicePtr should be a reference to a slice
+//
+// Validation errors are returned to the caller.
+func Compact(slicePtr interface{}) (int, error) {
+	slice, err := inPlaceSlice(slicePtr)
+	if err != nil {
+		return 0, err
+	}
+
+	return retain(slice, func(element reflect.Value) bool {
+		return !element.IsZero()
+	}), nil
+}
+
+// inPlaceSlice returns the settable slice slicePtr points to.
+func inPlaceSlice(slicePtr interface{}) (reflect.Value, error) {
+	ptr := reflect.ValueOf(slicePtr)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, fmt.Errorf("slicePtr (%v) has to be a reference to a slice", reflect.TypeOf(slicePtr))
+	}
+	return ptr.Elem(), nil
+}
+
+// retain moves the elements of slice for which keep returns true to its front, zeroes the rest of
+// its elements so that the backing array does not keep them alive, and shortens slice to the kept ones.
+func retain(slice reflect.Value, keep func(reflect.Value) bool) int {
+	kept := 0
+	for i := 0; i < slice.Len(); i++ {
+		element := slice.Index(i)
+		if !keep(element) {
+			continue
+		}
+		if i != kept {
+			slice.Index(kept).Set(element)
+		}
+		kept++
+	}
+
+	zero := reflect.Zero(slice.Type().Elem())
+	for i := kept; i < slice.Len(); i++ {
+		slice.Index(i).Set(zero)
+	}
+	slice.Set(slice.Slice(0, kept))
+
+	return kept
+}
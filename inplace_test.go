@@ -0,0 +1,243 @@
+package godash_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestFilterInPlace(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("should keep the elements passing the predicate in the same backing array", func(t *testing.T) {
+		backing := []int{1, 2, 3, 4, 5, 6}
+		in := backing
+
+		n, err := godash.FilterInPlace(&in, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []int{2, 4, 6}, in)
+		assert.Equal(t, []int{2, 4, 6, 0, 0, 0}, backing, "trailing elements should be zeroed")
+		assert.Equal(t, &backing[0], &in[0], "backing array should be reused")
+	})
+
+	t.Run("should zero out trailing pointers", func(t *testing.T) {
+		first, second := &version{"v1", 1}, &version{"v2", 2}
+		backing := []*version{first, second}
+		in := backing
+
+		n, err := godash.FilterInPlace(&in, func(v *version) bool { return v.Major == 2 })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []*version{second}, in)
+		assert.Nil(t, backing[1])
+	})
+
+	t.Run("should accept a matcher and an empty slice", func(t *testing.T) {
+		in := []version{{"v1", 1}, {"v2", 2}}
+		n, err := godash.FilterInPlace(&in, godash.MatchesProperty("Major", 1))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []version{{"v1", 1}}, in)
+
+		var empty []int
+		n, err = godash.FilterInPlace(&empty, isEven)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.Empty(t, empty)
+	})
+
+	t.Run("should validate slicePtr and the predicate", func(t *testing.T) {
+		in := []int{1}
+		_, err := godash.FilterInPlace(in, isEven)
+		assert.EqualError(t, err, "slicePtr ([]int) has to be a reference to a slice")
+
+		_, err = godash.FilterInPlace(nil, isEven)
+		assert.EqualError(t, err, "slicePtr (<nil>) has to be a reference to a slice")
+
+		var array [2]int
+		_, err = godash.FilterInPlace(&array, isEven)
+		assert.EqualError(t, err, "slicePtr (*[2]int) has to be a reference to a slice")
+
+		_, err = godash.FilterInPlace(&in, func(s string) bool { return true })
+		assert.EqualError(t, err, "predicate function's first argument has to be the type (int) instead of (string)")
+	})
+}
+
+func TestRemove(t *testing.T) {
+	t.Run("should remove the elements passing the predicate", func(t *testing.T) {
+		backing := []string{"a", "", "b", ""}
+		in := backing
+
+		n, err := godash.Remove(&in, func(s string) bool { return s == "" })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []string{"a", "b"}, in)
+		assert.Equal(t, []string{"a", "b", "", ""}, backing)
+	})
+
+	t.Run("should validate the predicate", func(t *testing.T) {
+		in := []string{"a"}
+		_, err := godash.Remove(&in, "")
+		assert.EqualError(t, err, "predicateFn has to be a function")
+	})
+}
+
+func TestMapInPlace(t *testing.T) {
+	t.Run("should replace each element with its mapped value", func(t *testing.T) {
+		in := []string{"a", "b"}
+		backing := in
+
+		n, err := godash.MapInPlace(&in, strings.ToUpper)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []string{"A", "B"}, in)
+		assert.Equal(t, []string{"A", "B"}, backing)
+	})
+
+	t.Run("should map with a path to a value of the element type", func(t *testing.T) {
+		type team struct {
+			Name string
+			Lead *team
+		}
+		lead := &team{Name: "platform"}
+		in := []*team{{Name: "infra", Lead: lead}}
+
+		_, err := godash.MapInPlace(&in, "Lead")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*team{lead}, in)
+	})
+
+	t.Run("should validate the mapper", func(t *testing.T) {
+		in := []int{1}
+		_, err := godash.MapInPlace(&in, 1)
+		assert.EqualError(t, err, "mapperFn has to be a function")
+
+		_, err = godash.MapInPlace(&in, func(a, b int) int { return a })
+		assert.EqualError(t, err, "mapper function has to take only one argument")
+
+		_, err = godash.MapInPlace(&in, func(s string) int { return 0 })
+		assert.EqualError(t, err, "mapper function's first argument (string) has to be (int)")
+
+		_, err = godash.MapInPlace(&in, func(n int) (int, error) { return n, nil })
+		assert.EqualError(t, err, "mapper function should return only one return value")
+
+		_, err = godash.MapInPlace(&in, func(n int) string { return "" })
+		assert.EqualError(t, err, "mapper function's return type (string) has to be (int)")
+	})
+}
+
+func TestPull(t *testing.T) {
+	t.Run("should remove the elements equal to any of values", func(t *testing.T) {
+		backing := []int{1, 2, 3, 1, 2, 3}
+		in := backing
+
+		n, err := godash.Pull(&in, 2, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []int{1, 1}, in)
+		assert.Equal(t, []int{1, 1, 0, 0, 0, 0}, backing)
+	})
+
+	t.Run("should compare uncomparable elements deeply", func(t *testing.T) {
+		in := [][]string{{"a"}, {"b"}, {"a"}}
+
+		n, err := godash.Pull(&in, []string{"a"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, [][]string{{"b"}}, in)
+	})
+
+	t.Run("should compare deeply if interface fields hold uncomparable values", func(t *testing.T) {
+		type setting struct {
+			Value interface{}
+		}
+		in := []setting{{[]string{"a"}}, {"b"}}
+
+		n, err := godash.Pull(&in, setting{[]string{"a"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []setting{{"b"}}, in)
+	})
+
+	t.Run("should pull nothing without values", func(t *testing.T) {
+		in := []int{1, 2}
+
+		n, err := godash.Pull(&in)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []int{1, 2}, in)
+	})
+
+	t.Run("should validate values", func(t *testing.T) {
+		in := []int{1}
+		_, err := godash.Pull(&in, 1, "1")
+		assert.EqualError(t, err, "value (1) has to be of input's element type (int)")
+	})
+}
+
+func TestCompact(t *testing.T) {
+	t.Run("should remove zero values", func(t *testing.T) {
+		numbers := []int{0, 1, 0, 2}
+		n, err := godash.Compact(&numbers)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []int{1, 2}, numbers)
+
+		errs := []error{nil, fmt.Errorf("failed"), nil}
+		n, err = godash.Compact(&errs)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.EqualError(t, errs[0], "failed")
+
+		versions := []version{{}, {"v1", 1}, {Major: 2}}
+		n, err = godash.Compact(&versions)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []version{{"v1", 1}, {Major: 2}}, versions)
+	})
+
+	t.Run("should validate slicePtr", func(t *testing.T) {
+		_, err := godash.Compact([]int{0})
+		assert.EqualError(t, err, "slicePtr ([]int) has to be a reference to a slice")
+	})
+}
+
+func ExampleFilterInPlace() {
+	ports := []int{22, 80, 443, 8080}
+	n, _ := godash.FilterInPlace(&ports, func(port int) bool { return port < 1024 })
+
+	fmt.Println(n, ports)
+
+	// Output: 3 [22 80 443]
+}
+
+func ExamplePull() {
+	tags := []string{"go", "wip", "lib", "draft"}
+	_, _ = godash.Pull(&tags, "wip", "draft")
+
+	fmt.Println(tags)
+
+	// Output: [go lib]
+}
+
+func ExampleCompact() {
+	fields := strings.Split("a,,b,,,c", ",")
+	_, _ = godash.Compact(&fields)
+
+	fmt.Println(fields)
+
+	// Output: [a b c]
+}
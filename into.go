@@ -0,0 +1,146 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MapInto is like Map, except that it reuses the slice out points to instead of allocating a new one.
+//
+// Out is overwritten with the results of mapperFn on each element of in. If its capacity is enough for them,
+// they are set in its backing array, and the elements between the new and the old length of out are
+// set to their zero value. Otherwise, a new slice is allocated as Map does.
+// This lets out be a buffer that is reused across calls, eg. one from a sync.Pool.
+//
+// Validations:
+//
+//	1. Output should be a reference to a slice
+//	2. Mapper function should take one argument of the type of input's elements and return one value
+//	3. Mapper function's return type should be output's element type
+//
+// Validation errors are returned to the caller.
+func MapInto(in, out, mapperFn interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("output (%s) should be a slice", output.Elem().Type())
+	}
+	input, err := iteratorElements(stringElements(reflect.ValueOf(in)))
+	if err != nil {
+		return err
+	}
+	if input.Kind() != reflect.Slice {
+		return fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+
+	mapper, err := mapperValue(input, mapperFn)
+	if err != nil {
+		return err
+	}
+	if mapper.Kind() != reflect.Func {
+		return fmt.Errorf("mapperFn has to be a function")
+	}
+	mapperFnType := mapper.Type()
+	if mapperFnType.NumIn() != 1 {
+		return fmt.Errorf("mapper function has to take only one argument")
+	}
+	if input.Type().Elem() != mapperFnType.In(0) {
+		return fmt.Errorf("mapper function's first argument (%s) has to be (%s)", mapperFnType.In(0), input.Type().Elem())
+	}
+	if mapperFnType.NumOut() != 1 {
+		return fmt.Errorf("mapper function should return only one return value")
+	}
+	if output.Elem().Type().Elem() != mapperFnType.Out(0) {
+		return fmt.Errorf("mapper function's return type has to be (%s) but is (%s)", output.Elem().Type().Elem(), mapperFnType.Out(0))
+	}
+
+	length := input.Len()
+	previous := output.Elem()
+	var result reflect.Value
+	if previous.Cap() >= length {
+		result = previous.Slice(0, length)
+	} else {
+		result = reflect.MakeSlice(previous.Type(), length, length)
+	}
+	for i := 0; i < length; i++ {
+		result.Index(i).Set(mapper.Call([]reflect.Value{input.Index(i)})[0])
+	}
+	setInto(output, result, previous.Len())
+
+	return nil
+}
+
+// FilterInto is like Filter, except that it reuses the slice out points to instead of allocating a new one.
+//
+// Out is overwritten with the elements of in that pass the predicate. They are set in the backing array
+// of out as long as its capacity is enough, and appended otherwise, which allocates a larger backing array.
+// The elements between the new and the old length of out are set to their zero value.
+// In can be out itself, in which case it is filtered in place like FilterInPlace does.
+//
+// Validations:
+//
+//	1. Output should be a reference to a slice of the type of input
+//	2. Predicate function should take one argument of the type of input's elements and return a boolean
+//
+// Validation errors are returned to the caller.
+func FilterInto(in, out, predicateFn interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("output (%s) should be a slice", output.Elem().Type())
+	}
+	input, err := iteratorElements(stringElements(reflect.ValueOf(in)))
+	if err != nil {
+		return err
+	}
+	if input.Kind() != reflect.Slice {
+		return fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+	if input.Type() != output.Elem().Type() {
+		return fmt.Errorf("input(%s) and output(%s) should be of the same Type", input.Type(), output.Elem().Type())
+	}
+
+	predicate, err := validatePredicate(input.Type().Elem(), predicateFn)
+	if err != nil {
+		return err
+	}
+
+	previous := output.Elem()
+	result := previous.Slice(0, 0)
+	for i := 0; i < input.Len(); i++ {
+		element := input.Index(i)
+		if !predicate.Call([]reflect.Value{element})[0].Bool() {
+			continue
+		}
+		if length := result.Len(); length < result.Cap() {
+			result = result.Slice(0, length+1)
+			result.Index(length).Set(element)
+		} else {
+			result = reflect.Append(result, element)
+		}
+	}
+	setInto(output, result, previous.Len())
+
+	return nil
+}
+
+// setInto sets the slice output points to as result, zeroing the elements of its backing array
+// from the length of result up to its previous length, if result shares the backing array.
+// A nil result is set as an empty slice, as Map and Filter do.
+func setInto(output, result reflect.Value, previousLength int) {
+	if result.IsNil() {
+		result = reflect.MakeSlice(result.Type(), 0, 0)
+	}
+	if result.Cap() == output.Elem().Cap() && result.Cap() > 0 && result.Pointer() == output.Elem().Pointer() {
+		extended := result.Slice(0, previousLength)
+		zero := reflect.Zero(result.Type().Elem())
+		for i := result.Len(); i < previousLength; i++ {
+			extended.Index(i).Set(zero)
+		}
+	}
+	output.Elem().Set(result)
+}
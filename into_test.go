@@ -0,0 +1,188 @@
+package godash_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestMapInto(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+
+	t.Run("should overwrite output in its backing array if it has enough capacity", func(t *testing.T) {
+		buffer := make([]int, 5, 10)
+		for i := range buffer {
+			buffer[i] = 100
+		}
+		out := buffer
+
+		err := godash.MapInto([]int{1, 2, 3}, &out, double)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, out)
+		assert.Equal(t, []int{2, 4, 6, 0, 0}, buffer, "elements after the new length should be zeroed")
+		assert.Equal(t, &buffer[0], &out[0], "backing array should be reused")
+	})
+
+	t.Run("should allocate a new slice if output does not have enough capacity", func(t *testing.T) {
+		buffer := []int{100}
+		out := buffer
+
+		err := godash.MapInto([]int{1, 2, 3}, &out, double)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, out)
+		assert.Equal(t, []int{100}, buffer)
+	})
+
+	t.Run("should set an empty slice for empty input", func(t *testing.T) {
+		var out []int
+		err := godash.MapInto([]int{}, &out, double)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, out)
+	})
+
+	t.Run("should map the input into itself", func(t *testing.T) {
+		in := []int{1, 2, 3}
+
+		err := godash.MapInto(in, &in, double)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, in)
+	})
+
+	t.Run("should map with a path, strings and iterators", func(t *testing.T) {
+		out := make([]string, 0, 4)
+		err := godash.MapInto([]version{{"v1", 1}, {"v2", 2}}, &out, "Name")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"v1", "v2"}, out)
+
+		err = godash.MapInto("ab", &out, func(r rune) string { return string(r) + "!" })
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a!", "b!"}, out)
+
+		numbers, _ := godash.RangeIterator(1, 4, 1)
+		err = godash.MapInto(numbers, &out, strconv.Itoa)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, out)
+	})
+
+	t.Run("should validate output and the mapper", func(t *testing.T) {
+		var out []int
+		err := godash.MapInto([]int{1}, out, double)
+		assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+
+		var notSlice map[int]int
+		err = godash.MapInto([]int{1}, &notSlice, double)
+		assert.EqualError(t, err, "output (map[int]int) should be a slice")
+
+		err = godash.MapInto(map[int]int{}, &out, double)
+		assert.EqualError(t, err, "not implemented for (map)")
+
+		err = godash.MapInto([]int{1}, &out, nil)
+		assert.EqualError(t, err, "mapperFn has to be a function")
+
+		err = godash.MapInto([]int{1}, &out, func(a, b int) int { return a })
+		assert.EqualError(t, err, "mapper function has to take only one argument")
+
+		err = godash.MapInto([]int{1}, &out, func(s string) int { return 0 })
+		assert.EqualError(t, err, "mapper function's first argument (string) has to be (int)")
+
+		err = godash.MapInto([]int{1}, &out, func(n int) (int, int) { return n, n })
+		assert.EqualError(t, err, "mapper function should return only one return value")
+
+		err = godash.MapInto([]int{1}, &out, strconv.Itoa)
+		assert.EqualError(t, err, "mapper function's return type has to be (int) but is (string)")
+	})
+}
+
+func TestFilterInto(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("should overwrite output in its backing array if it has enough capacity", func(t *testing.T) {
+		buffer := []int{100, 100, 100, 100}
+		out := buffer
+
+		err := godash.FilterInto([]int{1, 2, 3, 4, 5}, &out, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, out)
+		assert.Equal(t, []int{2, 4, 0, 0}, buffer, "elements after the new length should be zeroed")
+		assert.Equal(t, &buffer[0], &out[0], "backing array should be reused")
+	})
+
+	t.Run("should append to output beyond its capacity", func(t *testing.T) {
+		buffer := make([]int, 0, 1)
+		out := buffer
+
+		err := godash.FilterInto([]int{2, 4, 6}, &out, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, out)
+		assert.Equal(t, 2, buffer[:1][0], "elements within the capacity should be set in place")
+	})
+
+	t.Run("should filter the input into itself", func(t *testing.T) {
+		in := []int{1, 2, 3, 4}
+
+		err := godash.FilterInto(in, &in, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, in)
+	})
+
+	t.Run("should set an empty slice if no element passes", func(t *testing.T) {
+		var out []int
+		err := godash.FilterInto([]int{1, 3}, &out, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, out)
+	})
+
+	t.Run("should accept a matcher", func(t *testing.T) {
+		out := make([]version, 0, 2)
+		err := godash.FilterInto([]version{{"v1", 1}, {"v2", 2}}, &out, godash.MatchesProperty("Major", 2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []version{{"v2", 2}}, out)
+	})
+
+	t.Run("should validate output and the predicate", func(t *testing.T) {
+		var out []string
+		err := godash.FilterInto([]int{1}, &out, isEven)
+		assert.EqualError(t, err, "input([]int) and output([]string) should be of the same Type")
+
+		var notSlice string
+		err = godash.FilterInto("abc", &notSlice, func(r rune) bool { return true })
+		assert.EqualError(t, err, "output (string) should be a slice")
+
+		var numbers []int
+		err = godash.FilterInto([]int{1}, &numbers, func(n int) int { return n })
+		assert.EqualError(t, err, "predicate function should return only a (boolean) and not a (int)")
+	})
+}
+
+func ExampleMapInto() {
+	buffer := make([]string, 0, 16)
+	for _, batch := range [][]int{{1, 2, 3}, {4, 5}} {
+		_ = godash.MapInto(batch, &buffer, strconv.Itoa)
+		fmt.Println(buffer, cap(buffer))
+	}
+
+	// Output:
+	// [1 2 3] 16
+	// [4 5] 16
+}
+
+func ExampleFilterInto() {
+	buffer := make([]int, 0, 8)
+	_ = godash.FilterInto([]int{3, 8, 1, 9, 4}, &buffer, func(n int) bool { return n > 3 })
+
+	fmt.Println(buffer, cap(buffer))
+
+	// Output: [8 9 4] 8
+}
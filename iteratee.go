@@ -0,0 +1,224 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Matcher is a predicate shorthand that can be passed wherever a predicate function is accepted.
+// It is created by Matches or MatchesProperty and is turned into a predicate
+// for the element type of the input when the function is called.
+type Matcher struct {
+	properties map[string]interface{}
+}
+
+// Matches creates a Matcher that passes for elements which have all the given properties.
+// Keys of properties are paths like "Address.City" that are resolved against struct fields,
+// by name or json tag, and map keys. Values are compared with reflect.DeepEqual,
+// except for numbers which are compared by value irrespective of their type.
+//
+//	godash.Filter(users, &active, godash.Matches(map[string]interface{}{"Active": true}))
+func Matches(properties map[string]interface{}) Matcher {
+	return Matcher{properties: properties}
+}
+
+// MatchesProperty creates a Matcher that passes for elements whose value at path equals value.
+func MatchesProperty(path string, value interface{}) Matcher {
+	return Matches(map[string]interface{}{path: value})
+}
+
+func (m Matcher) predicateFor(elemType reflect.Type) (reflect.Value, error) {
+	for path := range m.properties {
+		if _, err := pathType(elemType, path); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	predicateType := reflect.FuncOf([]reflect.Type{elemType}, []reflect.Type{reflect.TypeOf(true)}, false)
+	return reflect.MakeFunc(predicateType, func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(m.matches(args[0]))}
+	}), nil
+}
+
+func (m Matcher) matches(element reflect.Value) bool {
+	for path, expected := range m.properties {
+		actual, ok := pathValue(element, path)
+		if !ok || !valuesMatch(actual, reflect.ValueOf(expected)) {
+			return false
+		}
+	}
+	return true
+}
+
+func valuesMatch(actual, expected reflect.Value) bool {
+	if actual.Kind() == reflect.Interface {
+		actual = actual.Elem()
+	}
+
+	if !expected.IsValid() {
+		if !actual.IsValid() {
+			return true
+		}
+		switch actual.Kind() {
+		case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Chan, reflect.Func:
+			return actual.IsNil()
+		}
+		return false
+	}
+	if !actual.IsValid() {
+		return false
+	}
+
+	if isNumber(actual.Kind()) && isNumber(expected.Kind()) {
+		return numbersEqual(actual, expected)
+	}
+	return reflect.DeepEqual(actual.Interface(), expected.Interface())
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isSigned(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isUnsigned(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uintptr
+}
+
+// convertNumber converts the number v to the numeric type t, and returns false if v overflows t
+// or if v is a float with a fraction and t is an integer.
+func convertNumber(v reflect.Value, t reflect.Type) (reflect.Value, bool) {
+	target := reflect.Zero(t)
+	switch {
+	case isSigned(t.Kind()):
+		switch {
+		case isSigned(v.Kind()):
+			return v.Convert(t), !target.OverflowInt(v.Int())
+		case isUnsigned(v.Kind()):
+			return v.Convert(t), v.Uint() <= math.MaxInt64 && !target.OverflowInt(int64(v.Uint()))
+		}
+		f := v.Float()
+		return v.Convert(t), f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 && !target.OverflowInt(int64(f))
+	case isUnsigned(t.Kind()):
+		switch {
+		case isSigned(v.Kind()):
+			return v.Convert(t), v.Int() >= 0 && !target.OverflowUint(uint64(v.Int()))
+		case isUnsigned(v.Kind()):
+			return v.Convert(t), !target.OverflowUint(v.Uint())
+		}
+		f := v.Float()
+		return v.Convert(t), f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 && !target.OverflowUint(uint64(f))
+	}
+	return v.Convert(t), !target.OverflowFloat(toFloat(v))
+}
+
+func numbersEqual(a, b reflect.Value) bool {
+	switch {
+	case isSigned(a.Kind()) && isSigned(b.Kind()):
+		return a.Int() == b.Int()
+	case isUnsigned(a.Kind()) && isUnsigned(b.Kind()):
+		return a.Uint() == b.Uint()
+	}
+	return toFloat(a) == toFloat(b)
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch {
+	case isSigned(v.Kind()):
+		return float64(v.Int())
+	case isUnsigned(v.Kind()):
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
+
+// validatePredicate returns predicateFn as a predicate function for elements of elemType.
+// A Matcher is turned into such a predicate, and functions are validated to take one element and return a bool.
+func validatePredicate(elemType reflect.Type, predicateFn interface{}) (reflect.Value, error) {
+	if matcher, ok := predicateFn.(Matcher); ok {
+		return matcher.predicateFor(elemType)
+	}
+
+	predicate := reflect.ValueOf(predicateFn)
+	if predicate.Kind() != reflect.Func {
+		return reflect.Value{}, fmt.Errorf("predicateFn has to be a function")
+	}
+
+	predicateFnType := predicate.Type()
+	if predicateFnType.NumIn() != 1 {
+		return reflect.Value{}, fmt.Errorf("predicate function has to take only one argument")
+	}
+	if predicateFnType.NumOut() != 1 {
+		return reflect.Value{}, fmt.Errorf("predicate function should return only one return value - a boolean")
+	}
+	if returnType := predicateFnType.Out(0).Kind(); returnType != reflect.Bool {
+		return reflect.Value{}, fmt.Errorf("predicate function should return only a (boolean) and not a (%s)", returnType)
+	}
+	if predicateFnType.In(0) != elemType {
+		return reflect.Value{}, fmt.Errorf("predicate function's first argument has to be the type (%s) instead of (%s)", elemType, predicateFnType.In(0))
+	}
+	return predicate, nil
+}
+
+// validateAllAnyPredicate is like validatePredicate except that it returns the errors All and Any always have.
+func validateAllAnyPredicate(elemType reflect.Type, predicateFn interface{}) (reflect.Value, error) {
+	if matcher, ok := predicateFn.(Matcher); ok {
+		return matcher.predicateFor(elemType)
+	}
+
+	predicate := reflect.ValueOf(predicateFn)
+	if predicate.Kind() != reflect.Func {
+		return reflect.Value{}, fmt.Errorf("predicateFn has to be a function")
+	}
+
+	predicateFnType := predicate.Type()
+	if predicateFnType.NumIn() != 1 {
+		return reflect.Value{}, fmt.Errorf("predicate function has to take only one argument")
+	}
+	if predicateFnType.NumOut() != 1 {
+		return reflect.Value{}, fmt.Errorf("predicate function should return only one return value")
+	}
+	if predicateFnType.Out(0).Kind() != reflect.Bool {
+		return reflect.Value{}, fmt.Errorf("predicate function should return a boolean value")
+	}
+	if predicateFnType.In(0) != elemType {
+		return reflect.Value{}, fmt.Errorf("predicate function's argument (%s) has to be (%s)", predicateFnType.In(0), elemType)
+	}
+	return predicate, nil
+}
+
+// mapperValue returns mapperFn as a reflect.Value.
+// If mapperFn is a string, it is treated as a path and a mapper function
+// that returns the value found at that path in the elements of input is built.
+func mapperValue(input reflect.Value, mapperFn interface{}) (reflect.Value, error) {
+	path, ok := mapperFn.(string)
+	if !ok || (input.Kind() != reflect.Slice && input.Kind() != reflect.Array) {
+		return reflect.ValueOf(mapperFn), nil
+	}
+	return propertyMapper(input.Type().Elem(), path)
+}
+
+func propertyMapper(elemType reflect.Type, path string) (reflect.Value, error) {
+	resultType, err := pathType(elemType, path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	mapperType := reflect.FuncOf([]reflect.Type{elemType}, []reflect.Type{resultType}, false)
+	return reflect.MakeFunc(mapperType, func(args []reflect.Value) []reflect.Value {
+		result := reflect.New(resultType).Elem()
+		if value, ok := pathValue(args[0], path); ok && value.Type().AssignableTo(resultType) {
+			result.Set(value)
+		}
+		return []reflect.Value{result}
+	}), nil
+}
@@ -0,0 +1,167 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type address struct {
+	City string `json:"city"`
+}
+
+type user struct {
+	Name    string
+	Age     int
+	Active  bool `json:"active"`
+	Address *address
+	Meta    map[string]interface{}
+	secret  string
+}
+
+var users = []user{
+	{Name: "John", Age: 22, Active: true, Address: &address{City: "Chennai"}, Meta: map[string]interface{}{"team": "core"}},
+	{Name: "Doe", Age: 30, Active: false, Address: &address{City: "Bangalore"}},
+	{Name: "Jane", Age: 30, Active: true},
+}
+
+func TestPropertyMapper(t *testing.T) {
+	t.Run("should map elements to the value of a field", func(t *testing.T) {
+		var out []string
+
+		err := godash.Map(users, &out, "Name")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"John", "Doe", "Jane"}, out)
+	})
+
+	t.Run("should follow nested paths through pointers and json tags", func(t *testing.T) {
+		var out []string
+
+		err := godash.Map(users, &out, "Address.city")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Chennai", "Bangalore", ""}, out)
+	})
+
+	t.Run("should follow map keys", func(t *testing.T) {
+		var out []interface{}
+
+		err := godash.Map(users, &out, "Meta.team")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"core", nil, nil}, out)
+	})
+
+	t.Run("should validate the path", func(t *testing.T) {
+		var out []string
+
+		{
+			err := godash.Map(users, &out, "Address.Street")
+			assert.EqualError(t, err, "path (Address.Street) at (Street): field not found in (godash_test.address)")
+		}
+		{
+			err := godash.Map(users, &out, "secret")
+			assert.EqualError(t, err, "path (secret) at (secret): field is not exported in (godash_test.user)")
+		}
+		{
+			err := godash.Map(users, &out, "Name.First")
+			assert.EqualError(t, err, "path (Name.First) at (First): field cannot be looked up in (string)")
+		}
+		{
+			err := godash.Map([]map[int]string{}, &out, "key")
+			assert.EqualError(t, err, "path (key) at (key): key cannot be looked up in (map[int]string)")
+		}
+	})
+
+	t.Run("should validate the output against the type of the field", func(t *testing.T) {
+		var out []int
+
+		err := godash.Map(users, &out, "Name")
+
+		assert.EqualError(t, err, "mapper function's return type has to be (string) but is (int)")
+	})
+}
+
+func TestMatches(t *testing.T) {
+	t.Run("should filter elements matching all properties", func(t *testing.T) {
+		var out []string
+		var matched []user
+
+		err := godash.Filter(users, &matched, godash.Matches(map[string]interface{}{"active": true, "Age": 30}))
+		_ = godash.Map(matched, &out, "Name")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Jane"}, out)
+	})
+
+	t.Run("should find element matching a nested property", func(t *testing.T) {
+		var out user
+
+		err := godash.Find(users, &out, godash.MatchesProperty("Address.City", "Bangalore"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Doe", out.Name)
+	})
+
+	t.Run("should compare numbers irrespective of their type", func(t *testing.T) {
+		ok, err := godash.Any(users, godash.MatchesProperty("Age", int64(22)))
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("should match nil against missing values", func(t *testing.T) {
+		ok, err := godash.All(users[1:], godash.MatchesProperty("Meta", nil))
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("should not match when the path goes through a nil pointer", func(t *testing.T) {
+		ok, err := godash.All(users, godash.MatchesProperty("Address.City", ""))
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("should validate the paths", func(t *testing.T) {
+		_, err := godash.Some(users, godash.Matches(map[string]interface{}{"Email": ""}))
+
+		assert.EqualError(t, err, "path (Email) at (Email): field not found in (godash_test.user)")
+	})
+}
+
+func ExampleMatches() {
+	type person struct {
+		Name   string
+		Active bool
+	}
+	input := []person{
+		{Name: "John", Active: true},
+		{Name: "Doe", Active: false},
+	}
+	var output []person
+
+	_ = godash.Filter(input, &output, godash.Matches(map[string]interface{}{"Active": true}))
+
+	fmt.Println(output)
+
+	// Output: [{John true}]
+}
+
+func ExampleMap_property() {
+	type person struct {
+		Name string `json:"name"`
+	}
+	input := []person{{Name: "John"}, {Name: "Doe"}}
+	var output []string
+
+	_ = godash.Map(input, &output, "name")
+
+	fmt.Println(output)
+
+	// Output: [John Doe]
+}
@@ -0,0 +1,249 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Iterator is a collection that is read one element at a time, like a linked list, a tree
+// or a cursor over the results of a query. Every function that takes a slice as input also takes an Iterator.
+//
+// The elements of an Iterator are of the type returned by ElemType if it is a TypedIterator,
+// or else of the type of its first element, and all of them should be assignable to that type.
+// An Iterator that is not a TypedIterator and has no elements is treated as having elements of type interface{}.
+//
+// ForEach, Take, TakeWhile, All, Any, Find, Includes and IndexOf read only as many elements as they need,
+// so they can be used with endless Iterators, though the first element of an Iterator that is not
+// a TypedIterator is always read to know its type. Other functions read all the elements before using them.
+type Iterator interface {
+	// Next returns the next element and true, or false once there are no more elements.
+	Next() (interface{}, bool)
+}
+
+// TypedIterator is an Iterator that knows the type of its elements.
+// Implementing it is needed for an Iterator whose elements are of an interface type, or for
+// an Iterator that can be empty to be passed to functions taking a function of its elements.
+type TypedIterator interface {
+	Iterator
+	// ElemType returns the type of the elements of the Iterator.
+	ElemType() reflect.Type
+}
+
+type funcIterator func() (interface{}, bool)
+
+func (next funcIterator) Next() (interface{}, bool) {
+	return next()
+}
+
+// FromFunc returns an Iterator whose elements are returned by next, until it returns false.
+//
+//	page := 0
+//	users := godash.FromFunc(func() (interface{}, bool) {
+//		page++
+//		return db.FetchUsers(page)
+//	})
+func FromFunc(next func() (interface{}, bool)) Iterator {
+	return funcIterator(next)
+}
+
+// Collect reads the remaining elements of it and sets them in out.
+// As the elements are set as is, Collect can be used to read an Iterator whose
+// elements are of different types into a slice of an interface type.
+//
+// Validations:
+//
+//	1. Output should be a reference to a slice
+//	2. Elements of it should be assignable to the element type of the output slice
+//
+// Validation errors are returned to the caller.
+func Collect(it Iterator, out interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("output (%s) should be a slice", output.Elem().Type())
+	}
+	if it == nil {
+		return fmt.Errorf("iterator is nil")
+	}
+
+	elements := &iteration{iterator: it, elemType: output.Elem().Type().Elem()}
+	result := reflect.MakeSlice(output.Elem().Type(), 0, 0)
+	for {
+		element, ok := elements.next()
+		if !ok {
+			break
+		}
+		result = reflect.Append(result, element)
+	}
+	if elements.err != nil {
+		return elements.err
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+var (
+	iteratorType  = reflect.TypeOf((*Iterator)(nil)).Elem()
+	interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// iteration reads the elements of an Iterator as values of elemType.
+// Reading stops at the first element that is not assignable to elemType, with err set.
+type iteration struct {
+	iterator Iterator
+	elemType reflect.Type
+	peeked   *reflect.Value
+	index    int
+	err      error
+}
+
+func implementsIterator(input reflect.Value) bool {
+	return input.IsValid() && input.Type().Implements(iteratorType)
+}
+
+// iteratorOf returns the Iterator input is, if it is one.
+// Unless it is a TypedIterator, its first element is read to know the type of its elements.
+func iteratorOf(input reflect.Value) (*iteration, bool) {
+	if !implementsIterator(input) {
+		return nil, false
+	}
+	iterator := input.Interface().(Iterator)
+
+	if typed, ok := iterator.(TypedIterator); ok {
+		return &iteration{iterator: iterator, elemType: typed.ElemType()}, true
+	}
+
+	elements := &iteration{iterator: iterator, elemType: interfaceType}
+	if first, ok := iterator.Next(); ok {
+		value := reflect.ValueOf(first)
+		if value.IsValid() {
+			elements.elemType = value.Type()
+		} else {
+			value = reflect.Zero(interfaceType)
+		}
+		elements.peeked = &value
+	}
+	return elements, true
+}
+
+func (i *iteration) next() (reflect.Value, bool) {
+	if i.err != nil {
+		return reflect.Value{}, false
+	}
+	if i.peeked != nil {
+		value := *i.peeked
+		i.peeked = nil
+		i.index++
+		return value, true
+	}
+
+	element, ok := i.iterator.Next()
+	if !ok {
+		return reflect.Value{}, false
+	}
+	value := reflect.New(i.elemType).Elem()
+	if element != nil {
+		if !reflect.TypeOf(element).AssignableTo(i.elemType) {
+			i.err = fmt.Errorf("iterator's element at (%d) of type (%s) is not of its element type (%s)", i.index, reflect.TypeOf(element), i.elemType)
+			return reflect.Value{}, false
+		}
+		value.Set(reflect.ValueOf(element))
+	} else if !isNillable(i.elemType.Kind()) {
+		i.err = fmt.Errorf("iterator's element at (%d) is nil, which is not a (%s)", i.index, i.elemType)
+		return reflect.Value{}, false
+	}
+	i.index++
+	return value, true
+}
+
+// recv is like next, and also returns the error reading stopped with, if any.
+func (i *iteration) recv() (reflect.Value, bool, error) {
+	element, ok := i.next()
+	return element, ok, i.err
+}
+
+// iteratorElements returns the elements of input in a slice if it is an Iterator, after reading all of them.
+// Other inputs are returned as is.
+func iteratorElements(input reflect.Value) (reflect.Value, error) {
+	elements, ok := iteratorOf(input)
+	if !ok {
+		return input, nil
+	}
+
+	result := reflect.MakeSlice(reflect.SliceOf(elements.elemType), 0, 0)
+	for {
+		element, ok := elements.next()
+		if !ok {
+			break
+		}
+		result = reflect.Append(result, element)
+	}
+	return result, elements.err
+}
+
+// lazyElements returns the type of the elements of input, which has to be a slice, an array or an Iterator,
+// along with a function receiving them one at a time. An Iterator is read only as its elements are received,
+// so that functions which stop at a decisive element do not read the rest of it.
+func lazyElements(input reflect.Value) (reflect.Type, func() (reflect.Value, bool, error), error) {
+	if iterator, ok := iteratorOf(input); ok {
+		return iterator.elemType, iterator.recv, nil
+	}
+	if input.Kind() != reflect.Slice && input.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+	return input.Type().Elem(), indexedElements(input.Len(), input.Index), nil
+}
+
+// indexedElements returns a function receiving the length elements returned by at, one at a time.
+func indexedElements(length int, at func(int) reflect.Value) func() (reflect.Value, bool, error) {
+	index := 0
+	return func() (reflect.Value, bool, error) {
+		if index == length {
+			return reflect.Value{}, false, nil
+		}
+		index++
+		return at(index - 1), true, nil
+	}
+}
+
+func isNillable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Chan, reflect.Func:
+		return true
+	}
+	return false
+}
+
+// generator is a TypedIterator of count elements, or of endless elements if count is negative,
+// where at returns the element at an index.
+type generator struct {
+	elemType reflect.Type
+	count    int64
+	index    int64
+	at       func(index int64) reflect.Value
+}
+
+func (g *generator) Next() (interface{}, bool) {
+	if g.count >= 0 && g.index >= g.count {
+		return nil, false
+	}
+	element := g.at(g.index)
+	g.index++
+	return element.Interface(), true
+}
+
+func (g *generator) ElemType() reflect.Type {
+	return g.elemType
+}
+
+// collect sets output, which is a slice of the element type of g, to the remaining elements of g.
+func (g *generator) collect(output reflect.Value) {
+	result := reflect.MakeSlice(output.Type(), 0, int(g.count-g.index))
+	for ; g.index < g.count; g.index++ {
+		result = reflect.Append(result, g.at(g.index))
+	}
+	output.Set(result)
+}
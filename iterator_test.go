@@ -0,0 +1,352 @@
+package godash_test
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+// listNode is a linked list that is iterated over with a cursor.
+type listNode struct {
+	value int
+	next  *listNode
+}
+
+func linkedList(values ...int) *listNode {
+	var head *listNode
+	for i := len(values) - 1; i >= 0; i-- {
+		head = &listNode{value: values[i], next: head}
+	}
+	return head
+}
+
+type cursor struct {
+	current *listNode
+	read    int
+}
+
+func (c *cursor) Next() (interface{}, bool) {
+	if c.current == nil {
+		return nil, false
+	}
+	value := c.current.value
+	c.current = c.current.next
+	c.read++
+	return value, true
+}
+
+func (n *listNode) iterate() *cursor {
+	return &cursor{current: n}
+}
+
+// typedCursor is a cursor that declares its elements to be ints, so that it can be empty.
+type typedCursor struct {
+	cursor
+}
+
+func (typedCursor) ElemType() reflect.Type {
+	return reflect.TypeOf(0)
+}
+
+func naturals() godash.Iterator {
+	n := 0
+	return godash.FromFunc(func() (interface{}, bool) {
+		n++
+		return n, true
+	})
+}
+
+func TestIterator(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("should map the elements of an iterator", func(t *testing.T) {
+		var out []string
+		err := godash.Map(linkedList(1, 2, 3).iterate(), &out, strconv.Itoa)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2", "3"}, out)
+	})
+
+	t.Run("should filter the elements of an iterator", func(t *testing.T) {
+		var out []int
+		err := godash.Filter(linkedList(1, 2, 3, 4).iterate(), &out, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, out)
+	})
+
+	t.Run("should find an element of an iterator", func(t *testing.T) {
+		var out int
+		err := godash.Find(linkedList(1, 2, 3, 4).iterate(), &out, isEven)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, out)
+	})
+
+	t.Run("should check all or any elements of an iterator", func(t *testing.T) {
+		all, err := godash.All(linkedList(2, 4).iterate(), isEven)
+		assert.NoError(t, err)
+		assert.True(t, all)
+
+		any, err := godash.Any(linkedList(1, 3).iterate(), isEven)
+		assert.NoError(t, err)
+		assert.False(t, any)
+	})
+
+	t.Run("should reduce the elements of an iterator", func(t *testing.T) {
+		var sum int
+		err := godash.Reduce(linkedList(1, 2, 3).iterate(), &sum, func(acc, n int) int { return acc + n })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 6, sum)
+	})
+
+	t.Run("should aggregate the elements of an iterator", func(t *testing.T) {
+		var sum, max int
+		var median float64
+		assert.NoError(t, godash.Sum(linkedList(1, 2, 3).iterate(), &sum))
+		assert.NoError(t, godash.Max(linkedList(1, 3, 2).iterate(), &max))
+		assert.NoError(t, godash.Median(linkedList(4, 1, 3).iterate(), &median))
+
+		assert.Equal(t, 6, sum)
+		assert.Equal(t, 3, max)
+		assert.Equal(t, 3.0, median)
+	})
+
+	t.Run("should build a map from an iterator of entries", func(t *testing.T) {
+		entries := []godash.Entry{{Key: "a", Value: 1}, {Key: "b", Value: 2}}
+		i := 0
+		it := godash.FromFunc(func() (interface{}, bool) {
+			if i == len(entries) {
+				return nil, false
+			}
+			i++
+			return entries[i-1], true
+		})
+
+		var out map[string]int
+		err := godash.FromEntries(it, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "b": 2}, out)
+	})
+
+	t.Run("should read only the elements needed by ForEach", func(t *testing.T) {
+		it := linkedList(1, 2, 3, 4).iterate()
+		var visited []int
+		err := godash.ForEach(it, func(n int) bool {
+			visited = append(visited, n)
+			return n < 2
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, visited)
+		assert.Equal(t, 2, it.read)
+	})
+
+	t.Run("should iterate an iterator from the right with ForEachRight", func(t *testing.T) {
+		var visited []int
+		err := godash.ForEachRight(linkedList(1, 2, 3).iterate(), func(n int) {
+			visited = append(visited, n)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 2, 1}, visited)
+	})
+
+	t.Run("should read only the elements taken from an iterator", func(t *testing.T) {
+		var out []int
+		err := godash.Take(naturals(), &out, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, out)
+
+		err = godash.TakeWhile(naturals(), &out, func(n int) bool { return n < 5 })
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, out)
+	})
+
+	t.Run("should stop reading an endless iterator at the first decisive element", func(t *testing.T) {
+		var found int
+		err := godash.Find(naturals(), &found, func(n int) bool { return n > 10 })
+		assert.NoError(t, err)
+		assert.Equal(t, 11, found)
+
+		all, err := godash.All(naturals(), func(n int) bool { return n < 5 })
+		assert.NoError(t, err)
+		assert.False(t, all)
+
+		any, err := godash.Any(naturals(), isEven)
+		assert.NoError(t, err)
+		assert.True(t, any)
+
+		included, err := godash.Includes(naturals(), 42)
+		assert.NoError(t, err)
+		assert.True(t, included)
+
+		index, err := godash.IndexOf(naturals(), 7)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, index)
+	})
+
+	t.Run("should read only the elements needed by Find, All, Any and Includes", func(t *testing.T) {
+		it := linkedList(1, 2, 3, 4).iterate()
+		var found int
+		err := godash.Find(it, &found, isEven)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, found)
+		assert.Equal(t, 2, it.read)
+
+		it = linkedList(1, 2, 3, 4).iterate()
+		_, err = godash.Includes(it, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, it.read)
+	})
+
+	t.Run("should return errors of elements read lazily", func(t *testing.T) {
+		values := []interface{}{1, "two"}
+		i := 0
+		it := godash.FromFunc(func() (interface{}, bool) {
+			if i == len(values) {
+				return nil, false
+			}
+			i++
+			return values[i-1], true
+		})
+
+		_, err := godash.All(it, func(n int) bool { return true })
+		assert.EqualError(t, err, "iterator's element at (1) of type (string) is not of its element type (int)")
+	})
+
+	t.Run("should drop elements of an iterator", func(t *testing.T) {
+		var out []int
+		err := godash.Drop(linkedList(1, 2, 3).iterate(), &out, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 3}, out)
+	})
+
+	t.Run("should use the element type of a typed iterator when it is empty", func(t *testing.T) {
+		var out []string
+		err := godash.Map(&typedCursor{}, &out, strconv.Itoa)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, out)
+	})
+
+	t.Run("should treat an empty iterator as having elements of type interface{}", func(t *testing.T) {
+		var out []interface{}
+		err := godash.Filter(linkedList().iterate(), &out, func(interface{}) bool { return true })
+
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{}, out)
+	})
+
+	t.Run("should not allow elements of different types", func(t *testing.T) {
+		values := []interface{}{1, "two"}
+		i := 0
+		it := godash.FromFunc(func() (interface{}, bool) {
+			if i == len(values) {
+				return nil, false
+			}
+			i++
+			return values[i-1], true
+		})
+
+		var out []int
+		err := godash.Map(it, &out, func(n int) int { return n })
+		assert.EqualError(t, err, "iterator's element at (1) of type (string) is not of its element type (int)")
+	})
+
+	t.Run("should validate functions against the element type of the iterator", func(t *testing.T) {
+		err := godash.ForEach(linkedList(1).iterate(), func(string) {})
+		assert.EqualError(t, err, "iteratee function's argument (string) has to be (int)")
+
+		var out []string
+		err = godash.Take(linkedList(1).iterate(), &out, 1)
+		assert.EqualError(t, err, "output ([]string) should be a slice of (int)")
+	})
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("should collect the remaining elements of an iterator", func(t *testing.T) {
+		it := linkedList(1, 2, 3).iterate()
+		it.Next()
+
+		var out []int
+		err := godash.Collect(it, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 3}, out)
+	})
+
+	t.Run("should collect elements of different types into a slice of an interface type", func(t *testing.T) {
+		values := []interface{}{1, "two", nil}
+		i := 0
+		it := godash.FromFunc(func() (interface{}, bool) {
+			if i == len(values) {
+				return nil, false
+			}
+			i++
+			return values[i-1], true
+		})
+
+		var out []interface{}
+		err := godash.Collect(it, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, values, out)
+	})
+
+	t.Run("should validate elements are assignable to the output", func(t *testing.T) {
+		var out []string
+		err := godash.Collect(linkedList(1).iterate(), &out)
+		assert.EqualError(t, err, "iterator's element at (0) of type (int) is not of its element type (string)")
+
+		var notSlice int
+		err = godash.Collect(linkedList(1).iterate(), &notSlice)
+		assert.EqualError(t, err, "output (int) should be a slice")
+
+		err = godash.Collect(nil, &out)
+		assert.EqualError(t, err, "iterator is nil")
+	})
+}
+
+func ExampleFromFunc() {
+	pages := [][]string{{"alice", "bob"}, {"carol"}}
+	page := 0
+	users := godash.FromFunc(func() (interface{}, bool) {
+		if page == len(pages) {
+			return nil, false
+		}
+		page++
+		return pages[page-1], true
+	})
+
+	var counts []int
+	_ = godash.Map(users, &counts, func(users []string) int {
+		return len(users)
+	})
+
+	fmt.Println(counts)
+
+	// Output: [2 1]
+}
+
+func ExampleCollect() {
+	n := 0
+	squares := godash.FromFunc(func() (interface{}, bool) {
+		n++
+		return n * n, n <= 4
+	})
+
+	var out []int
+	_ = godash.Collect(squares, &out)
+
+	fmt.Println(out)
+
+	// Output: [1 4 9 16]
+}
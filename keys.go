@@ -0,0 +1,218 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Entry is a key and value pair of a map or a struct, as set by Entries and read by FromEntries.
+// Entries and FromEntries also accept any other struct with Key and Value fields.
+type Entry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Keys sets out to a slice of the keys of in.
+//
+// In can be a map, in which case keys are sorted if they are numbers or strings,
+// or a struct, in which case keys are the field names (or json tags) in the order laid out by ToMap.
+//
+// Validations:
+//
+//	1. Input should be a map or a struct
+//	2. Output should be a reference to a slice
+//	3. Output slice's element type should be the key type of the map, or string for structs.
+//
+// Validation errors are returned to the caller.
+func Keys(in, out interface{}) error {
+	keys, _, keyType, _, err := entriesOf(in)
+	if err != nil {
+		return err
+	}
+	return setSlice(out, keys, keyType)
+}
+
+// Values sets out to a slice of the values of in, in the same order as Keys.
+//
+// Validations:
+//
+//	1. Input should be a map or a struct
+//	2. Output should be a reference to a slice
+//	3. Output slice's element type should be the value type of the map. For structs, it should be the type of
+//	   all the fields if they are of the same type, or interface{} otherwise.
+//
+// Validation errors are returned to the caller.
+func Values(in, out interface{}) error {
+	_, values, _, valueType, err := entriesOf(in)
+	if err != nil {
+		return err
+	}
+	return setSlice(out, values, valueType)
+}
+
+// Entries sets out to a slice of key and value pairs of in, in the same order as Keys.
+// Output's element can be Entry, or any struct with Key and Value fields.
+//
+// Validations:
+//
+//	1. Input should be a map or a struct
+//	2. Output should be a reference to a slice of structs with Key and Value fields
+//	3. Key and Value fields should be of the key and value types of in, as in Keys and Values
+//
+// Validation errors are returned to the caller.
+func Entries(in, out interface{}) error {
+	keys, values, keyType, valueType, err := entriesOf(in)
+	if err != nil {
+		return err
+	}
+
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("output should be a slice of entries and not (%s)", output.Elem().Type())
+	}
+
+	entryType := output.Elem().Type().Elem()
+	keyField, valueField, err := entryFields(entryType)
+	if err != nil {
+		return err
+	}
+	if !keyType.AssignableTo(keyField.Type) {
+		return fmt.Errorf("output entry's Key (%s) has to be (%s)", keyField.Type, keyType)
+	}
+	if !valueType.AssignableTo(valueField.Type) {
+		return fmt.Errorf("output entry's Value (%s) has to be (%s)", valueField.Type, valueType)
+	}
+
+	result := reflect.MakeSlice(output.Elem().Type(), len(keys), len(keys))
+	for i := range keys {
+		result.Index(i).FieldByIndex(keyField.Index).Set(keys[i])
+		result.Index(i).FieldByIndex(valueField.Index).Set(values[i])
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+// FromEntries sets out to a map built from a slice of key and value pairs.
+// Input's element can be Entry, or any struct with Key and Value fields. Later entries overwrite earlier ones with the same key.
+//
+// Validations:
+//
+//	1. Input should be a slice or an array of structs with Key and Value fields
+//	2. Output should be a reference to a map
+//	3. Keys and values should be assignable to the key and value types of the output map. Numbers are converted if they fit exactly.
+//
+// Validation errors are returned to the caller.
+func FromEntries(in, out interface{}) error {
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	if input.Kind() != reflect.Slice && input.Kind() != reflect.Array {
+		return fmt.Errorf("input has to be a slice of entries and not (%s)", input.Kind())
+	}
+
+	keyField, valueField, err := entryFields(input.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	mapType := output.Elem().Type()
+	if mapType.Kind() != reflect.Map {
+		return fmt.Errorf("output should be a map for input of entries")
+	}
+
+	result := reflect.MakeMapWithSize(mapType, input.Len())
+	for i := 0; i < input.Len(); i++ {
+		key, err := assignable(input.Index(i).FieldByIndex(keyField.Index), mapType.Key(), fmt.Sprintf("[%d].Key", i))
+		if err != nil {
+			return err
+		}
+		value, err := assignable(input.Index(i).FieldByIndex(valueField.Index), mapType.Elem(), fmt.Sprintf("[%d].Value", i))
+		if err != nil {
+			return err
+		}
+		result.SetMapIndex(key, value)
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+// entriesOf returns the keys and values of the map or struct in, along with their types.
+func entriesOf(in interface{}) (keys, values []reflect.Value, keyType, valueType reflect.Type, err error) {
+	input := indirect(reflect.ValueOf(in))
+
+	switch input.Kind() {
+	case reflect.Map:
+		keys = input.MapKeys()
+		if isOrdered(input.Type().Key().Kind()) {
+			sort.Slice(keys, func(i, j int) bool {
+				return less(keys[i], keys[j])
+			})
+		}
+		for _, key := range keys {
+			values = append(values, input.MapIndex(key))
+		}
+		return keys, values, input.Type().Key(), input.Type().Elem(), nil
+	case reflect.Struct:
+		for _, entry := range structEntries(input) {
+			keys = append(keys, reflect.ValueOf(entry.key))
+			values = append(values, entry.value)
+			if valueType == nil {
+				valueType = entry.value.Type()
+			} else if valueType != entry.value.Type() {
+				valueType = reflect.TypeOf((*interface{})(nil)).Elem()
+			}
+		}
+		if valueType == nil {
+			valueType = reflect.TypeOf((*interface{})(nil)).Elem()
+		}
+		return keys, values, reflect.TypeOf(""), valueType, nil
+	}
+
+	return nil, nil, nil, nil, fmt.Errorf("not implemented for (%s)", reflect.ValueOf(in).Kind())
+}
+
+// entryFields returns the Key and Value fields of entryType.
+func entryFields(entryType reflect.Type) (key, value reflect.StructField, err error) {
+	if entryType.Kind() == reflect.Struct {
+		var hasKey, hasValue bool
+		key, hasKey = entryType.FieldByName("Key")
+		value, hasValue = entryType.FieldByName("Value")
+		if hasKey && hasValue && key.PkgPath == "" && value.PkgPath == "" {
+			return key, value, nil
+		}
+	}
+	return key, value, fmt.Errorf("entry (%s) has to be a struct with Key and Value fields", entryType)
+}
+
+// setSlice sets out to a slice of elements, all of type elemType.
+func setSlice(out interface{}, elements []reflect.Value, elemType reflect.Type) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("output should be a slice and not (%s)", output.Elem().Type())
+	}
+	if outputElemType := output.Elem().Type().Elem(); !elemType.AssignableTo(outputElemType) {
+		return fmt.Errorf("output slice's element (%s) has to be (%s)", outputElemType, elemType)
+	}
+
+	result := reflect.MakeSlice(output.Elem().Type(), len(elements), len(elements))
+	for i, element := range elements {
+		result.Index(i).Set(element)
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type item struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type score struct {
+	Key   string
+	Value int
+}
+
+func TestKeys(t *testing.T) {
+	t.Run("should set sorted keys of a map", func(t *testing.T) {
+		var out []int
+
+		err := godash.Keys(map[int]string{3: "c", 1: "a", 2: "b"}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, out)
+	})
+
+	t.Run("should set field names of a struct", func(t *testing.T) {
+		var out []string
+
+		err := godash.Keys(&item{}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"name", "color"}, out)
+	})
+
+	t.Run("should skip shadowed fields of embedded structs", func(t *testing.T) {
+		var out []string
+
+		err := godash.Keys(layered{extra: &extra{}}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Kind", "Name"}, out)
+	})
+
+	t.Run("should validate input and output", func(t *testing.T) {
+		{
+			var out []string
+			err := godash.Keys([]int{}, &out)
+			assert.EqualError(t, err, "not implemented for (slice)")
+		}
+		{
+			var out []string
+			err := godash.Keys(map[int]string{}, &out)
+			assert.EqualError(t, err, "output slice's element (string) has to be (int)")
+		}
+		{
+			var out string
+			err := godash.Keys(map[string]int{}, &out)
+			assert.EqualError(t, err, "output should be a slice and not (string)")
+		}
+		{
+			err := godash.Keys(map[string]int{}, nil)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+	})
+}
+
+func TestValues(t *testing.T) {
+	t.Run("should set values of a map in the order of keys", func(t *testing.T) {
+		var out []string
+
+		err := godash.Values(map[string]string{"b": "banana", "a": "apple"}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"apple", "banana"}, out)
+	})
+
+	t.Run("should set values of a struct", func(t *testing.T) {
+		{
+			var out []string
+			err := godash.Values(item{Name: "apple", Color: "red"}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"apple", "red"}, out)
+		}
+		{
+			var out []interface{}
+			err := godash.Values(score{Key: "john", Value: 10}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, []interface{}{"john", 10}, out)
+		}
+		{
+			var out []string
+			err := godash.Values(score{}, &out)
+			assert.EqualError(t, err, "output slice's element (string) has to be (interface {})")
+		}
+	})
+}
+
+func TestEntries(t *testing.T) {
+	t.Run("should set entries of a map sorted by key", func(t *testing.T) {
+		var out []godash.Entry
+
+		err := godash.Entries(map[string]int{"b": 2, "a": 1}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []godash.Entry{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, out)
+	})
+
+	t.Run("should set typed entries", func(t *testing.T) {
+		var out []score
+
+		err := godash.Entries(map[string]int{"john": 10, "doe": 20}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []score{{Key: "doe", Value: 20}, {Key: "john", Value: 10}}, out)
+	})
+
+	t.Run("should set entries of a struct", func(t *testing.T) {
+		var out []godash.Entry
+
+		err := godash.Entries(item{Name: "apple", Color: "red"}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []godash.Entry{{Key: "name", Value: "apple"}, {Key: "color", Value: "red"}}, out)
+	})
+
+	t.Run("should validate output", func(t *testing.T) {
+		{
+			var out []string
+			err := godash.Entries(map[string]int{}, &out)
+			assert.EqualError(t, err, "entry (string) has to be a struct with Key and Value fields")
+		}
+		{
+			var out []score
+			err := godash.Entries(map[int]int{}, &out)
+			assert.EqualError(t, err, "output entry's Key (string) has to be (int)")
+		}
+		{
+			var out []score
+			err := godash.Entries(map[string]string{}, &out)
+			assert.EqualError(t, err, "output entry's Value (int) has to be (string)")
+		}
+		{
+			var out map[string]int
+			err := godash.Entries(map[string]int{}, &out)
+			assert.EqualError(t, err, "output should be a slice of entries and not (map[string]int)")
+		}
+	})
+}
+
+func TestFromEntries(t *testing.T) {
+	t.Run("should build a map from entries", func(t *testing.T) {
+		{
+			var out map[string]int
+			err := godash.FromEntries([]score{{Key: "john", Value: 10}, {Key: "john", Value: 30}, {Key: "doe", Value: 20}}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]int{"john": 30, "doe": 20}, out)
+		}
+		{
+			var out map[string]int64
+			err := godash.FromEntries([]godash.Entry{{Key: "john", Value: 10}}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]int64{"john": 10}, out)
+		}
+	})
+
+	t.Run("should validate input and output", func(t *testing.T) {
+		var out map[string]int
+
+		{
+			err := godash.FromEntries("entries", &out)
+			assert.EqualError(t, err, "input has to be a slice of entries and not (string)")
+		}
+		{
+			err := godash.FromEntries([]int{1}, &out)
+			assert.EqualError(t, err, "entry (int) has to be a struct with Key and Value fields")
+		}
+		{
+			var out []int
+			err := godash.FromEntries([]score{}, &out)
+			assert.EqualError(t, err, "output should be a map for input of entries")
+		}
+		{
+			err := godash.FromEntries([]godash.Entry{{Key: 1, Value: 1}}, &out)
+			assert.EqualError(t, err, "path ([0].Key) at (Key): value of type (int) cannot be assigned to (string)")
+		}
+	})
+}
+
+func ExampleKeys() {
+	input := map[string]int{"b": 2, "c": 3, "a": 1}
+	var output []string
+
+	_ = godash.Keys(input, &output)
+
+	fmt.Println(output)
+
+	// Output: [a b c]
+}
+
+func ExampleEntries() {
+	input := map[string]int{"b": 2, "a": 1}
+	var output []godash.Entry
+
+	_ = godash.Entries(input, &output)
+
+	fmt.Println(output)
+
+	// Output: [{a 1} {b 2}]
+}
+
+func ExampleFromEntries() {
+	input := []godash.Entry{{Key: "a", Value: 1}, {Key: "b", Value: 2}}
+	var output map[string]int
+
+	_ = godash.FromEntries(input, &output)
+
+	fmt.Println(output)
+
+	// Output: map[a:1 b:2]
+}
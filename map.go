@@ -7,6 +7,8 @@ import (
 
 // Map applies mapperFn on each item of in and puts it in out.
 // Currently, input and output for type slice is supported.
+// Strings are supported as slices of their runes, or of their bytes if they are of type Bytes,
+// and out can be a string if mapperFn returns a rune or a byte.
 //
 // Validations:
 //
@@ -14,27 +16,44 @@ import (
 //	2. Mapper function's argument should be of the same type of each element of input slice.
 //	3. Mapper function's output should be of the same type of each element of output slice.
 //
+// For input of type slice, mapperFn can also be a path like "Address.City" instead of a function.
+// The value found at that path in each element, through struct fields (by name or json tag),
+// map keys and pointers, is then put in out.
+//
+// Out can also be a map, in which case mapperFn has to return a key and a value, eg. func(T) (K, V),
+// which are put in out. Later elements overwrite earlier ones with the same key.
+//
+// Out is replaced with a new slice on every call. Use MapInto to reuse its backing array instead.
+//
 // Validation failures are returned as error by the godash.Map to the caller.
 func Map(in, out, mapperFn interface{}) error {
-	input := reflect.ValueOf(in)
+	input, err := iteratorElements(stringElements(reflect.ValueOf(in)))
+	if err != nil {
+		return err
+	}
 	output := reflect.ValueOf(out)
 	if err := validateOut(output); err != nil {
 		return err
 	}
 
-	mapper := reflect.ValueOf(mapperFn)
+	mapper, err := mapperValue(input, mapperFn)
+	if err != nil {
+		return err
+	}
 	if mapper.Kind() != reflect.Func {
 		return fmt.Errorf("mapperFn has to be a function")
 	}
 
 	mapperFnType := mapper.Type()
+	outputIsMap := output.Elem().Kind() == reflect.Map
+	outputIsString := output.Elem().Kind() == reflect.String
 
-	if mapperFnType.NumOut() != 1 {
+	if mapperFnType.NumOut() != 1 && !(outputIsMap && mapperFnType.NumOut() == 2) {
 		return fmt.Errorf("mapper function should return only one return value")
 	}
 
 	if input.Kind() == reflect.Slice {
-		if output.Elem().Kind() != reflect.Slice {
+		if !outputIsMap && !outputIsString && output.Elem().Kind() != reflect.Slice {
 			return fmt.Errorf("output should be a slice for input of type slice")
 		}
 
@@ -45,11 +64,20 @@ func Map(in, out, mapperFn interface{}) error {
 		if input.Type().Elem() != mapper.Type().In(0) {
 			return fmt.Errorf("mapper function's first argument (%s) has to be (%s)", mapper.Type().In(0), input.Type().Elem())
 		}
-		if output.Elem().Type().Elem() != mapper.Type().Out(0) {
+		if outputIsMap {
+			return mapToMap(input, output, mapper)
+		}
+		resultType := output.Elem().Type()
+		if outputIsString {
+			if !isRuneOrByte(mapper.Type().Out(0)) {
+				return fmt.Errorf("mapper function's return type has to be (rune) or (byte) for output of type string but is (%s)", mapper.Type().Out(0))
+			}
+			resultType = reflect.SliceOf(mapper.Type().Out(0))
+		} else if output.Elem().Type().Elem() != mapper.Type().Out(0) {
 			return fmt.Errorf("mapper function's return type has to be (%s) but is (%s)", mapper.Type().Out(0), output.Elem().Type().Elem())
 		}
 
-		result := reflect.MakeSlice(output.Elem().Type(), 0, input.Len())
+		result := reflect.MakeSlice(resultType, 0, input.Len())
 		for i := 0; i < input.Len(); i++ {
 			arg := input.Index(i)
 
@@ -57,13 +85,13 @@ func Map(in, out, mapperFn interface{}) error {
 
 			result = reflect.Append(result, returnValues[0])
 		}
-		output.Elem().Set(result)
+		output.Elem().Set(result.Convert(output.Elem().Type()))
 
 		return nil
 	}
 
 	if input.Kind() == reflect.Map {
-		if output.Elem().Kind() != reflect.Slice {
+		if !outputIsMap && output.Elem().Kind() != reflect.Slice {
 			return fmt.Errorf("output should be a slice for input of type slice")
 		}
 
@@ -77,6 +105,9 @@ func Map(in, out, mapperFn interface{}) error {
 		if mapper.Type().In(1) != input.Type().Elem() {
 			return fmt.Errorf("mapper function's second argument (%s) has to be (%s)", mapper.Type().In(1), input.Type().Elem())
 		}
+		if outputIsMap {
+			return mapToMap(input, output, mapper)
+		}
 		if mapper.Type().Out(0) != output.Elem().Type().Elem() {
 			return fmt.Errorf("mapper function's return type has to be (%s) but is (%s)", mapper.Type().Out(0), output.Elem().Type().Elem())
 		}
@@ -95,3 +126,34 @@ func Map(in, out, mapperFn interface{}) error {
 	}
 	return fmt.Errorf("not implemented")
 }
+
+// mapToMap sets out to a map of the keys and values returned by mapper for each element of in.
+func mapToMap(input, output, mapper reflect.Value) error {
+	mapperFnType := mapper.Type()
+	outputType := output.Elem().Type()
+	if mapperFnType.NumOut() != 2 {
+		return fmt.Errorf("mapper function should return a key and a value for output of type map")
+	}
+	if mapperFnType.Out(0) != outputType.Key() {
+		return fmt.Errorf("mapper function's first return type (%s) has to be (%s)", mapperFnType.Out(0), outputType.Key())
+	}
+	if mapperFnType.Out(1) != outputType.Elem() {
+		return fmt.Errorf("mapper function's second return type (%s) has to be (%s)", mapperFnType.Out(1), outputType.Elem())
+	}
+
+	result := reflect.MakeMapWithSize(outputType, input.Len())
+	if input.Kind() == reflect.Map {
+		for _, key := range input.MapKeys() {
+			returnValues := mapper.Call([]reflect.Value{key, input.MapIndex(key)})
+			result.SetMapIndex(returnValues[0], returnValues[1])
+		}
+	} else {
+		for i := 0; i < input.Len(); i++ {
+			returnValues := mapper.Call([]reflect.Value{input.Index(i)})
+			result.SetMapIndex(returnValues[0], returnValues[1])
+		}
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
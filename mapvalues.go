@@ -0,0 +1,179 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// CollisionPolicy decides what MapKeys does when two keys are mapped to the same key.
+type CollisionPolicy int
+
+const (
+	// CollisionError returns an error when two keys are mapped to the same key.
+	CollisionError CollisionPolicy = iota
+	// CollisionKeepFirst keeps the value of the first key mapped to a key.
+	CollisionKeepFirst
+	// CollisionKeepLast keeps the value of the last key mapped to a key.
+	CollisionKeepLast
+)
+
+// MapKeysOption configures MapKeys.
+type MapKeysOption func(*mapKeysOptions)
+
+type mapKeysOptions struct {
+	collisionPolicy CollisionPolicy
+}
+
+// OnCollision sets what MapKeys does when two keys are mapped to the same key.
+// By default, an error is returned.
+func OnCollision(policy CollisionPolicy) MapKeysOption {
+	return func(options *mapKeysOptions) {
+		options.collisionPolicy = policy
+	}
+}
+
+// MapValues applies mapperFn on each value of the map in and puts it in out under the same key.
+// MapperFn can be of the form func(V) V2 or func(K, V) V2.
+//
+// Validations:
+//
+//	1. Input should be a map and output should be a reference to a map
+//	2. Input and output maps should have the same key type
+//	3. Mapper function should take the map's value, or the map's key and value, as arguments and return one value
+//	4. Mapper function's return type should be the output map's value type
+//
+// Validation errors are returned to the caller.
+func MapValues(in, out, mapperFn interface{}) error {
+	input, output, mapper, err := validateMapToMap(in, out, mapperFn)
+	if err != nil {
+		return err
+	}
+
+	if input.Type().Key() != output.Elem().Type().Key() {
+		return fmt.Errorf("input(%s) and output(%s) should have the same key type", input.Type(), output.Elem().Type())
+	}
+	if mapper.Type().NumIn() == 1 && mapper.Type().In(0) != input.Type().Elem() {
+		return fmt.Errorf("mapper function's argument (%s) has to be (%s)", mapper.Type().In(0), input.Type().Elem())
+	}
+	if mapper.Type().Out(0) != output.Elem().Type().Elem() {
+		return fmt.Errorf("mapper function's return type (%s) has to be (%s)", mapper.Type().Out(0), output.Elem().Type().Elem())
+	}
+
+	result := reflect.MakeMapWithSize(output.Elem().Type(), input.Len())
+	for _, key := range input.MapKeys() {
+		value := input.MapIndex(key)
+		args := []reflect.Value{value}
+		if mapper.Type().NumIn() == 2 {
+			args = []reflect.Value{key, value}
+		}
+		result.SetMapIndex(key, mapper.Call(args)[0])
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+// MapKeys applies mapperFn on each key of the map in and puts the value in out under the returned key.
+// MapperFn can be of the form func(K) K2 or func(K, V) K2.
+//
+// When two keys are mapped to the same key, an error is returned unless a different policy is set with OnCollision.
+// Keys are visited in sorted order when they are numbers or strings, so that the first and the last keys are well defined.
+//
+// Validations:
+//
+//	1. Input should be a map and output should be a reference to a map
+//	2. Input and output maps should have the same value type
+//	3. Mapper function should take the map's key, or the map's key and value, as arguments and return one value
+//	4. Mapper function's return type should be the output map's key type
+//
+// Validation errors are returned to the caller.
+func MapKeys(in, out, mapperFn interface{}, opts ...MapKeysOption) error {
+	input, output, mapper, err := validateMapToMap(in, out, mapperFn)
+	if err != nil {
+		return err
+	}
+
+	var options mapKeysOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if input.Type().Elem() != output.Elem().Type().Elem() {
+		return fmt.Errorf("input(%s) and output(%s) should have the same value type", input.Type(), output.Elem().Type())
+	}
+	if mapper.Type().NumIn() == 1 && mapper.Type().In(0) != input.Type().Key() {
+		return fmt.Errorf("mapper function's argument (%s) has to be (%s)", mapper.Type().In(0), input.Type().Key())
+	}
+	if mapper.Type().Out(0) != output.Elem().Type().Key() {
+		return fmt.Errorf("mapper function's return type (%s) has to be (%s)", mapper.Type().Out(0), output.Elem().Type().Key())
+	}
+
+	keys := input.MapKeys()
+	if isOrdered(input.Type().Key().Kind()) {
+		sort.Slice(keys, func(i, j int) bool {
+			return less(keys[i], keys[j])
+		})
+	}
+
+	result := reflect.MakeMapWithSize(output.Elem().Type(), input.Len())
+	for _, key := range keys {
+		value := input.MapIndex(key)
+		args := []reflect.Value{key}
+		if mapper.Type().NumIn() == 2 {
+			args = []reflect.Value{key, value}
+		}
+		mappedKey := mapper.Call(args)[0]
+
+		if result.MapIndex(mappedKey).IsValid() {
+			switch options.collisionPolicy {
+			case CollisionKeepFirst:
+				continue
+			case CollisionError:
+				return fmt.Errorf("key (%v) is mapped to (%v) which is already mapped from another key", key, mappedKey)
+			}
+		}
+		result.SetMapIndex(mappedKey, value)
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+func validateMapToMap(in, out, mapperFn interface{}) (input, output, mapper reflect.Value, err error) {
+	input = reflect.ValueOf(in)
+	output = reflect.ValueOf(out)
+	if err = validateOut(output); err != nil {
+		return
+	}
+	if input.Kind() != reflect.Map {
+		return input, output, mapper, fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+	if output.Elem().Kind() != reflect.Map {
+		return input, output, mapper, fmt.Errorf("output should be a map for input of type map")
+	}
+
+	mapper = reflect.ValueOf(mapperFn)
+	if mapper.Kind() != reflect.Func {
+		return input, output, mapper, fmt.Errorf("mapperFn has to be a function")
+	}
+
+	mapperFnType := mapper.Type()
+	if mapperFnType.NumOut() != 1 {
+		return input, output, mapper, fmt.Errorf("mapper function should return only one return value")
+	}
+
+	switch mapperFnType.NumIn() {
+	case 1:
+		return input, output, mapper, nil
+	case 2:
+		if mapperFnType.In(0) != input.Type().Key() {
+			return input, output, mapper, fmt.Errorf("mapper function's first argument (%s) has to be (%s)", mapperFnType.In(0), input.Type().Key())
+		}
+		if mapperFnType.In(1) != input.Type().Elem() {
+			return input, output, mapper, fmt.Errorf("mapper function's second argument (%s) has to be (%s)", mapperFnType.In(1), input.Type().Elem())
+		}
+		return input, output, mapper, nil
+	}
+	return input, output, mapper, fmt.Errorf("mapper function has to take one or two arguments")
+}
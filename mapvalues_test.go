@@ -0,0 +1,234 @@
+package godash_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestMapValues(t *testing.T) {
+	t.Run("should map values keeping keys", func(t *testing.T) {
+		var out map[string]string
+
+		err := godash.MapValues(map[string]int{"a": 1, "b": 2}, &out, func(value int) string {
+			return strings.Repeat("*", value)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "*", "b": "**"}, out)
+	})
+
+	t.Run("should pass key and value to mapper taking two arguments", func(t *testing.T) {
+		var out map[string]string
+
+		err := godash.MapValues(map[string]int{"a": 1, "b": 2}, &out, func(key string, value int) string {
+			return fmt.Sprintf("%s%d", key, value)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "a1", "b": "b2"}, out)
+	})
+
+	t.Run("should validate input, output and mapper", func(t *testing.T) {
+		in := map[string]int{"a": 1}
+
+		{
+			var out map[string]int
+			err := godash.MapValues([]int{}, &out, func(int) int { return 0 })
+			assert.EqualError(t, err, "not implemented for (slice)")
+		}
+		{
+			var out []int
+			err := godash.MapValues(in, &out, func(int) int { return 0 })
+			assert.EqualError(t, err, "output should be a map for input of type map")
+		}
+		{
+			var out map[int]int
+			err := godash.MapValues(in, &out, func(int) int { return 0 })
+			assert.EqualError(t, err, "input(map[string]int) and output(map[int]int) should have the same key type")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, 1)
+			assert.EqualError(t, err, "mapperFn has to be a function")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, func(int) {})
+			assert.EqualError(t, err, "mapper function should return only one return value")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, func() int { return 0 })
+			assert.EqualError(t, err, "mapper function has to take one or two arguments")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, func(string) int { return 0 })
+			assert.EqualError(t, err, "mapper function's argument (string) has to be (int)")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, func(int, int) int { return 0 })
+			assert.EqualError(t, err, "mapper function's first argument (int) has to be (string)")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, func(string, string) int { return 0 })
+			assert.EqualError(t, err, "mapper function's second argument (string) has to be (int)")
+		}
+		{
+			var out map[string]int
+			err := godash.MapValues(in, &out, func(int) string { return "" })
+			assert.EqualError(t, err, "mapper function's return type (string) has to be (int)")
+		}
+	})
+}
+
+func TestMapKeys(t *testing.T) {
+	in := map[string]int{"Apple": 1, "apple": 2, "Banana": 3}
+
+	t.Run("should map keys keeping values", func(t *testing.T) {
+		var out map[string]int
+
+		err := godash.MapKeys(map[string]int{"a": 1, "b": 2}, &out, strings.ToUpper)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"A": 1, "B": 2}, out)
+	})
+
+	t.Run("should pass key and value to mapper taking two arguments", func(t *testing.T) {
+		var out map[string]int
+
+		err := godash.MapKeys(map[string]int{"a": 1}, &out, func(key string, value int) string {
+			return fmt.Sprintf("%s%d", key, value)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a1": 1}, out)
+	})
+
+	t.Run("should handle collisions as per policy", func(t *testing.T) {
+		{
+			var out map[string]int
+			err := godash.MapKeys(in, &out, strings.ToLower)
+			assert.EqualError(t, err, "key (apple) is mapped to (apple) which is already mapped from another key")
+		}
+		{
+			var out map[string]int
+			err := godash.MapKeys(in, &out, strings.ToLower, godash.OnCollision(godash.CollisionKeepFirst))
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]int{"apple": 1, "banana": 3}, out)
+		}
+		{
+			var out map[string]int
+			err := godash.MapKeys(in, &out, strings.ToLower, godash.OnCollision(godash.CollisionKeepLast))
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]int{"apple": 2, "banana": 3}, out)
+		}
+	})
+
+	t.Run("should validate output and mapper", func(t *testing.T) {
+		{
+			var out map[string]string
+			err := godash.MapKeys(in, &out, strings.ToLower)
+			assert.EqualError(t, err, "input(map[string]int) and output(map[string]string) should have the same value type")
+		}
+		{
+			var out map[string]int
+			err := godash.MapKeys(in, &out, func(int) string { return "" })
+			assert.EqualError(t, err, "mapper function's argument (int) has to be (string)")
+		}
+		{
+			var out map[int]int
+			err := godash.MapKeys(in, &out, strings.ToLower)
+			assert.EqualError(t, err, "mapper function's return type (string) has to be (int)")
+		}
+	})
+}
+
+func TestMapToMap(t *testing.T) {
+	t.Run("should map slices to maps", func(t *testing.T) {
+		var out map[string]int
+
+		err := godash.Map([]string{"a", "bb"}, &out, func(el string) (string, int) {
+			return el, len(el)
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "bb": 2}, out)
+	})
+
+	t.Run("should map maps to maps", func(t *testing.T) {
+		var out map[int]string
+
+		err := godash.Map(map[string]int{"a": 1, "b": 2}, &out, func(key string, value int) (int, string) {
+			return value, key
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: "a", 2: "b"}, out)
+	})
+
+	t.Run("should validate mapper for output of type map", func(t *testing.T) {
+		var out map[string]int
+
+		{
+			err := godash.Map([]string{"a"}, &out, func(el string) string { return el })
+			assert.EqualError(t, err, "mapper function should return a key and a value for output of type map")
+		}
+		{
+			err := godash.Map([]string{"a"}, &out, func(el string) (int, int) { return 0, 0 })
+			assert.EqualError(t, err, "mapper function's first return type (int) has to be (string)")
+		}
+		{
+			err := godash.Map(map[string]int{}, &out, func(string, int) (string, string) { return "", "" })
+			assert.EqualError(t, err, "mapper function's second return type (string) has to be (int)")
+		}
+		{
+			var out []int
+			err := godash.Map([]string{"a"}, &out, func(el string) (int, int) { return 0, 0 })
+			assert.EqualError(t, err, "mapper function should return only one return value")
+		}
+	})
+}
+
+func ExampleMapValues() {
+	input := map[string]int{"a": 1, "b": 2}
+	var output map[string]int
+
+	_ = godash.MapValues(input, &output, func(num int) int {
+		return num * 10
+	})
+
+	fmt.Println(output)
+
+	// Output: map[a:10 b:20]
+}
+
+func ExampleMapKeys() {
+	input := map[string]int{"a": 1, "b": 2}
+	var output map[string]int
+
+	_ = godash.MapKeys(input, &output, strings.ToUpper)
+
+	fmt.Println(output)
+
+	// Output: map[A:1 B:2]
+}
+
+func ExampleMap_toMap() {
+	input := []string{"rhythm", "of", "life"}
+	var output map[string]int
+
+	_ = godash.Map(input, &output, func(word string) (string, int) {
+		return word, len(word)
+	})
+
+	fmt.Println(output)
+
+	// Output: map[life:4 of:2 rhythm:6]
+}
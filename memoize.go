@@ -0,0 +1,153 @@
+package godash
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Memoize sets wrapped to a function that caches the results of fn by its arguments.
+//
+// Arguments are used as the cache key as is, so they should be comparable. Functions with other arguments,
+// like slices or variadic arguments, need a key resolver passed with WithKeyResolver.
+// Arguments of interface types should hold comparable values, as Go panics when comparing other values.
+// Results of calls that return a non-nil error as their last value are not cached.
+//
+// Cached results can be made to expire with WithTTL and the number of cached results can be bounded with WithMaxSize.
+// The wrapped function is safe for concurrent use, though fn may be called more than once for the same
+// arguments if they are called concurrently before the result is cached.
+//
+// Validations:
+//
+//	1. Fn should be a function
+//	2. Wrapped should be a reference to a function of the same type as fn
+//	3. Arguments of fn should be comparable unless a key resolver is passed
+//	4. Key resolver should take the same arguments as fn and return one comparable value
+//
+// Validation errors are returned to the caller.
+func Memoize(fn, wrapped interface{}, opts ...FuncOption) error {
+	function, output, err := validateWrapped(fn, wrapped)
+	if err != nil {
+		return err
+	}
+	options := newFuncOptions(opts)
+
+	keyOf, err := memoizeKey(function.Type(), options.keyResolver)
+	if err != nil {
+		return err
+	}
+
+	cache := &memoizeCache{options: options, entries: map[interface{}]*list.Element{}, order: list.New()}
+	output.Set(reflect.MakeFunc(function.Type(), func(args []reflect.Value) []reflect.Value {
+		key := keyOf(args)
+		if results, ok := cache.get(key); ok {
+			return results
+		}
+
+		results := callFn(function, args)
+		if last := len(results) - 1; last < 0 || results[last].Type() != errorType || results[last].IsNil() {
+			cache.put(key, results)
+		}
+		return results
+	}))
+
+	return nil
+}
+
+// memoizeKey returns a function that computes the cache key from the arguments of a call to a function of fnType.
+func memoizeKey(fnType reflect.Type, resolverFn interface{}) (func([]reflect.Value) interface{}, error) {
+	if resolverFn != nil {
+		resolver := reflect.ValueOf(resolverFn)
+		if resolver.Kind() != reflect.Func {
+			return nil, fmt.Errorf("key resolver has to be a function")
+		}
+
+		resolverType := resolver.Type()
+		if resolverType.NumIn() != fnType.NumIn() || resolverType.IsVariadic() != fnType.IsVariadic() {
+			return nil, fmt.Errorf("key resolver has to take the same arguments as fn (%s)", fnType)
+		}
+		for i := 0; i < fnType.NumIn(); i++ {
+			if resolverType.In(i) != fnType.In(i) {
+				return nil, fmt.Errorf("key resolver has to take the same arguments as fn (%s)", fnType)
+			}
+		}
+		if resolverType.NumOut() != 1 || !resolverType.Out(0).Comparable() {
+			return nil, fmt.Errorf("key resolver should return only one comparable value")
+		}
+
+		return func(args []reflect.Value) interface{} {
+			return callFn(resolver, args)[0].Interface()
+		}, nil
+	}
+
+	for i := 0; i < fnType.NumIn(); i++ {
+		if fnType.IsVariadic() && i == fnType.NumIn()-1 || !fnType.In(i).Comparable() {
+			return nil, fmt.Errorf("argument (%s) of fn is not comparable, pass a key resolver with WithKeyResolver", fnType.In(i))
+		}
+	}
+
+	keyType := reflect.ArrayOf(fnType.NumIn(), reflect.TypeOf((*interface{})(nil)).Elem())
+	return func(args []reflect.Value) interface{} {
+		key := reflect.New(keyType).Elem()
+		for i, arg := range args {
+			key.Index(i).Set(arg)
+		}
+		return key.Interface()
+	}, nil
+}
+
+type memoizeEntry struct {
+	key       interface{}
+	results   []reflect.Value
+	expiresAt time.Time
+}
+
+// memoizeCache holds results in entries, with order having the most recently used entries at the front.
+type memoizeCache struct {
+	sync.Mutex
+	options funcOptions
+	entries map[interface{}]*list.Element
+	order   *list.List
+}
+
+func (c *memoizeCache) get(key interface{}) ([]reflect.Value, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoizeEntry)
+	if c.options.ttl > 0 && !c.options.clock.Now().Before(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry.results, true
+}
+
+func (c *memoizeCache) put(key interface{}, results []reflect.Value) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry := &memoizeEntry{key: key, results: results}
+	if c.options.ttl > 0 {
+		entry.expiresAt = c.options.clock.Now().Add(c.options.ttl)
+	}
+	if element, ok := c.entries[key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	if c.options.maxSize > 0 && c.order.Len() > c.options.maxSize {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoizeEntry).key)
+	}
+}
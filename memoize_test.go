@@ -0,0 +1,140 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestMemoize(t *testing.T) {
+	t.Run("should cache results by arguments", func(t *testing.T) {
+		calls := 0
+		var add func(int, int) int
+
+		err := godash.Memoize(func(a, b int) int {
+			calls++
+			return a + b
+		}, &add)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 3, add(1, 2))
+		assert.Equal(t, 3, add(1, 2))
+		assert.Equal(t, 5, add(2, 3))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should not cache calls that return an error", func(t *testing.T) {
+		calls := 0
+		var fetch func(string) (string, error)
+		_ = godash.Memoize(func(key string) (string, error) {
+			calls++
+			if calls == 1 {
+				return "", errors.New("unavailable")
+			}
+			return strings.ToUpper(key), nil
+		}, &fetch)
+
+		_, err := fetch("a")
+		assert.EqualError(t, err, "unavailable")
+		value, err := fetch("a")
+		assert.NoError(t, err)
+		assert.Equal(t, "A", value)
+		_, _ = fetch("a")
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should use the key resolver for arguments that are not comparable", func(t *testing.T) {
+		calls := 0
+		var join func(...string) string
+		err := godash.Memoize(func(parts ...string) string {
+			calls++
+			return strings.Join(parts, ",")
+		}, &join, godash.WithKeyResolver(func(parts ...string) string {
+			return strings.Join(parts, "\x00")
+		}))
+		assert.NoError(t, err)
+
+		assert.Equal(t, "a,b", join("a", "b"))
+		assert.Equal(t, "a,b", join("a", "b"))
+		assert.Equal(t, "a", join("a"))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should expire results after TTL", func(t *testing.T) {
+		clock := newFakeClock()
+		calls := 0
+		var square func(int) int
+		_ = godash.Memoize(func(n int) int {
+			calls++
+			return n * n
+		}, &square, godash.WithTTL(time.Minute), godash.WithClock(clock))
+
+		square(2)
+		clock.Advance(59 * time.Second)
+		square(2)
+		assert.Equal(t, 1, calls)
+
+		clock.Advance(time.Second)
+		square(2)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should evict the least recently used result beyond max size", func(t *testing.T) {
+		var calls []int
+		var square func(int) int
+		_ = godash.Memoize(func(n int) int {
+			calls = append(calls, n)
+			return n * n
+		}, &square, godash.WithMaxSize(2))
+
+		square(1)
+		square(2)
+		square(1)
+		square(3)
+		square(1)
+		square(2)
+
+		assert.Equal(t, []int{1, 2, 3, 2}, calls)
+	})
+
+	t.Run("should validate arguments and key resolver", func(t *testing.T) {
+		var wrapped func([]int) int
+		fn := func([]int) int { return 0 }
+		{
+			err := godash.Memoize(fn, &wrapped)
+			assert.EqualError(t, err, "argument ([]int) of fn is not comparable, pass a key resolver with WithKeyResolver")
+		}
+		{
+			err := godash.Memoize(fn, &wrapped, godash.WithKeyResolver("key"))
+			assert.EqualError(t, err, "key resolver has to be a function")
+		}
+		{
+			err := godash.Memoize(fn, &wrapped, godash.WithKeyResolver(func(int) int { return 0 }))
+			assert.EqualError(t, err, "key resolver has to take the same arguments as fn (func([]int) int)")
+		}
+		{
+			err := godash.Memoize(fn, &wrapped, godash.WithKeyResolver(func(in []int) []int { return in }))
+			assert.EqualError(t, err, "key resolver should return only one comparable value")
+		}
+	})
+}
+
+func ExampleMemoize() {
+	var fibonacci func(int) int
+	_ = godash.Memoize(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return fibonacci(n-1) + fibonacci(n-2)
+	}, &fibonacci)
+
+	fmt.Println(fibonacci(80))
+
+	// Output: 23416728348467685
+}
@@ -0,0 +1,299 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SliceStrategy decides how slices are merged by Merge.
+type SliceStrategy int
+
+const (
+	// SliceReplace replaces the destination slice with the source slice.
+	SliceReplace SliceStrategy = iota
+	// SliceAppend appends the elements of the source slice to the destination slice.
+	SliceAppend
+	// SliceMergeByIndex merges elements at the same index and appends the remaining elements of the source slice.
+	SliceMergeByIndex
+)
+
+// MergeOption configures Merge, MergeWith and Defaults.
+// Options can be passed anywhere among the sources and apply to the whole merge.
+type MergeOption func(*mergeOptions)
+
+type mergeOptions struct {
+	sliceStrategy     SliceStrategy
+	overwriteWithZero bool
+	onlyZero          bool
+}
+
+// WithSliceStrategy sets how slices are merged. Slices are replaced by default.
+func WithSliceStrategy(strategy SliceStrategy) MergeOption {
+	return func(options *mergeOptions) {
+		options.sliceStrategy = strategy
+	}
+}
+
+// OverwriteWithZero makes zero values in sources overwrite values in the destination.
+// By default, zero values in sources are skipped.
+func OverwriteWithZero() MergeOption {
+	return func(options *mergeOptions) {
+		options.overwriteWithZero = true
+	}
+}
+
+// Merge recursively merges srcs into dst from left to right.
+//
+// Maps are merged key by key and structs field by field. Pointers and interfaces are followed
+// and merged into what they point to. Slices are merged as per WithSliceStrategy and all other values are
+// overwritten by the value in the source. Zero values in sources are skipped unless OverwriteWithZero is passed.
+// Values taken from sources are deep copied using CloneDeep, so dst does not share references with srcs.
+// Cyclic values are supported, as pointers, maps and slices already being merged are not merged again.
+//
+// Validations:
+//
+//	1. Dst should be a reference so that it can be set
+//	2. Each source should be of the same type as dst or a pointer to it
+//	3. Values at the same path of dst and a source should be of the same type
+//
+// Validation errors are returned to the caller.
+func Merge(dst interface{}, srcs ...interface{}) error {
+	return merge(dst, reflect.Value{}, srcs, mergeOptions{})
+}
+
+// MergeWith is like Merge except that customizerFn is invoked to produce the merged value
+// whenever values of its argument type are merged.
+// customizerFn is of the form func(dst, src T) (T, bool) and returning false falls back to merging as Merge does.
+//
+// Validations in addition to that of Merge:
+//
+//	1. Customizer function should take two arguments of the same type
+//	2. Customizer function should return a value of the argument type and a bool
+func MergeWith(dst, customizerFn interface{}, srcs ...interface{}) error {
+	customizer := reflect.ValueOf(customizerFn)
+	if customizer.Kind() != reflect.Func {
+		return fmt.Errorf("customizerFn has to be a function")
+	}
+
+	customizerFnType := customizer.Type()
+	if customizerFnType.NumIn() != 2 || customizerFnType.In(0) != customizerFnType.In(1) {
+		return fmt.Errorf("customizer function has to take exactly two arguments of the same type")
+	}
+	if customizerFnType.NumOut() != 2 || customizerFnType.Out(0) != customizerFnType.In(0) || customizerFnType.Out(1).Kind() != reflect.Bool {
+		return fmt.Errorf("customizer function should return a (%s) and a (bool)", customizerFnType.In(0))
+	}
+
+	return merge(dst, customizer, srcs, mergeOptions{})
+}
+
+// Defaults is like Merge except that only zero values in dst are set from srcs.
+// Sources are applied from left to right, so the first source that has a value wins.
+func Defaults(dst interface{}, srcs ...interface{}) error {
+	return merge(dst, reflect.Value{}, srcs, mergeOptions{onlyZero: true})
+}
+
+func merge(dst interface{}, customizer reflect.Value, srcs []interface{}, options mergeOptions) error {
+	output := reflect.ValueOf(dst)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	m := &merger{options: options, customizer: customizer}
+
+	var sources []reflect.Value
+	for _, src := range srcs {
+		if opt, ok := src.(MergeOption); ok {
+			opt(&m.options)
+			continue
+		}
+		sources = append(sources, reflect.ValueOf(src))
+	}
+
+	dstType := output.Elem().Type()
+	for _, source := range sources {
+		if source.IsValid() && source.Type() == reflect.PtrTo(dstType) {
+			source = source.Elem()
+		}
+		if !source.IsValid() {
+			continue
+		}
+		if source.Type() != dstType {
+			return fmt.Errorf("source(%s) has to be the same Type as dst(%s)", source.Type(), dstType)
+		}
+
+		m.visited = map[[2]uintptr]bool{}
+		result, err := m.merge(addressableCopy(output.Elem()), source)
+		if err != nil {
+			return err
+		}
+		output.Elem().Set(result)
+	}
+
+	return nil
+}
+
+type merger struct {
+	options    mergeOptions
+	customizer reflect.Value
+	// visited holds the (dst, src) pairs of pointers, maps and slices being merged, so that cycles are merged once.
+	visited map[[2]uintptr]bool
+}
+
+// visit reports whether dst and src, which are pointers, maps or slices, are already being merged,
+// and marks them as being merged otherwise.
+func (m *merger) visit(dst, src reflect.Value) bool {
+	key := [2]uintptr{dst.Pointer(), src.Pointer()}
+	if m.visited[key] {
+		return true
+	}
+	m.visited[key] = true
+	return false
+}
+
+// skips reports whether src is left out when merged into a value of type dstType,
+// as it is invalid or a zero value and OverwriteWithZero is not passed.
+func (m *merger) skips(dstType reflect.Type, src reflect.Value) bool {
+	if src.Kind() == reflect.Interface && dstType.Kind() != reflect.Interface {
+		src = src.Elem()
+	}
+	return !src.IsValid() || (!m.options.overwriteWithZero && src.IsZero())
+}
+
+// merge returns the result of merging src into dst. dst has to be settable.
+func (m *merger) merge(dst, src reflect.Value) (reflect.Value, error) {
+	if m.skips(dst.Type(), src) {
+		return dst, nil
+	}
+	if src.Kind() == reflect.Interface && dst.Kind() != reflect.Interface {
+		src = src.Elem()
+	}
+
+	if m.customizer.IsValid() && m.customizer.Type().In(0) == dst.Type() && src.Type() == dst.Type() {
+		returnValues := m.customizer.Call([]reflect.Value{dst, src})
+		if returnValues[1].Bool() {
+			return returnValues[0], nil
+		}
+	}
+
+	switch dst.Kind() {
+	case reflect.Interface:
+		if src.Kind() == reflect.Interface {
+			if src = src.Elem(); !src.IsValid() {
+				return reflect.Zero(dst.Type()), nil
+			}
+		}
+		if dst.IsNil() || dst.Elem().Type() != src.Type() {
+			return m.replace(dst, src)
+		}
+		merged, err := m.merge(addressableCopy(dst.Elem()), src)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		result := reflect.New(dst.Type()).Elem()
+		result.Set(merged)
+		return result, nil
+	case reflect.Ptr:
+		if dst.IsNil() || src.Type() != dst.Type() {
+			return m.replace(dst, src)
+		}
+		if m.visit(dst, src) {
+			return dst, nil
+		}
+		merged, err := m.merge(dst.Elem(), src.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		dst.Elem().Set(merged)
+		return dst, nil
+	case reflect.Map:
+		if dst.IsNil() || src.Type() != dst.Type() {
+			return m.replace(dst, src)
+		}
+		if m.visit(dst, src) {
+			return dst, nil
+		}
+		for _, key := range src.MapKeys() {
+			value := src.MapIndex(key)
+			existing := dst.MapIndex(key)
+			if !existing.IsValid() {
+				if m.skips(dst.Type().Elem(), value) {
+					continue
+				}
+				existing = reflect.Zero(dst.Type().Elem())
+			}
+			merged, err := m.merge(addressableCopy(existing), value)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			dst.SetMapIndex(key, merged)
+		}
+		return dst, nil
+	case reflect.Struct:
+		if src.Type() != dst.Type() {
+			return m.replace(dst, src)
+		}
+		for i := 0; i < dst.NumField(); i++ {
+			if dst.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			merged, err := m.merge(dst.Field(i), src.Field(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			dst.Field(i).Set(merged)
+		}
+		return dst, nil
+	case reflect.Slice:
+		if dst.IsNil() || src.Type() != dst.Type() || m.options.onlyZero && dst.Len() > 0 {
+			return m.replace(dst, src)
+		}
+		switch m.options.sliceStrategy {
+		case SliceAppend:
+			cloned, err := cloneValue(src)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return reflect.AppendSlice(dst, cloned), nil
+		case SliceMergeByIndex:
+			if m.visit(dst, src) {
+				return dst, nil
+			}
+			for i := 0; i < src.Len(); i++ {
+				if i >= dst.Len() {
+					dst = reflect.Append(dst, reflect.Zero(dst.Type().Elem()))
+				}
+				merged, err := m.merge(dst.Index(i), src.Index(i))
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				dst.Index(i).Set(merged)
+			}
+			return dst, nil
+		}
+	}
+
+	return m.replace(dst, src)
+}
+
+// replace returns a copy of src to be used in place of dst.
+// In Defaults, dst is retained unless it is a zero value.
+func (m *merger) replace(dst, src reflect.Value) (reflect.Value, error) {
+	if m.options.onlyZero && !dst.IsZero() {
+		return dst, nil
+	}
+	if !src.Type().AssignableTo(dst.Type()) {
+		return reflect.Value{}, fmt.Errorf("cannot merge (%s) into (%s)", src.Type(), dst.Type())
+	}
+	cloned, err := cloneValue(src)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	result := reflect.New(dst.Type()).Elem()
+	result.Set(cloned)
+	return result, nil
+}
+
+func cloneValue(v reflect.Value) (reflect.Value, error) {
+	c := &deepCloner{visited: map[visit]reflect.Value{}}
+	return c.clone(v)
+}
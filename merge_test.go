@@ -0,0 +1,257 @@
+package godash_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type database struct {
+	Host    string
+	Port    int
+	Options map[string]string
+}
+
+type appConfig struct {
+	Name     string
+	Debug    bool
+	Database *database
+	Plugins  []string
+	Extra    map[string]interface{}
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("should recursively merge structs, pointers and maps", func(t *testing.T) {
+		dst := appConfig{
+			Name:     "app",
+			Database: &database{Host: "localhost", Port: 5432, Options: map[string]string{"sslmode": "disable"}},
+		}
+		src := appConfig{
+			Debug:    true,
+			Database: &database{Host: "db.internal", Options: map[string]string{"timeout": "5s"}},
+		}
+
+		err := godash.Merge(&dst, src)
+
+		expected := appConfig{
+			Name:     "app",
+			Debug:    true,
+			Database: &database{Host: "db.internal", Port: 5432, Options: map[string]string{"sslmode": "disable", "timeout": "5s"}},
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("should merge sources from left to right", func(t *testing.T) {
+		dst := map[string]interface{}{"a": 1}
+		first := map[string]interface{}{"b": map[string]interface{}{"c": 2}}
+		second := map[string]interface{}{"a": 3, "b": map[string]interface{}{"d": 4}}
+
+		err := godash.Merge(&dst, first, &second)
+
+		expected := map[string]interface{}{"a": 3, "b": map[string]interface{}{"c": 2, "d": 4}}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("should not share references with sources", func(t *testing.T) {
+		var dst appConfig
+		src := appConfig{Database: &database{Host: "localhost"}, Plugins: []string{"auth"}}
+
+		err := godash.Merge(&dst, src)
+		dst.Database.Host = "changed"
+		dst.Plugins[0] = "changed"
+
+		assert.NoError(t, err)
+		assert.Equal(t, "localhost", src.Database.Host)
+		assert.Equal(t, []string{"auth"}, src.Plugins)
+	})
+
+	t.Run("should merge slices as per strategy", func(t *testing.T) {
+		merged := func(opts ...interface{}) []map[string]int {
+			dst := []map[string]int{{"a": 1}, {"b": 2}}
+			srcs := append([]interface{}{[]map[string]int{{"c": 3}}}, opts...)
+			assert.NoError(t, godash.Merge(&dst, srcs...))
+			return dst
+		}
+
+		assert.Equal(t, []map[string]int{{"c": 3}}, merged())
+		assert.Equal(t, []map[string]int{{"c": 3}}, merged(godash.WithSliceStrategy(godash.SliceReplace)))
+		assert.Equal(t, []map[string]int{{"a": 1}, {"b": 2}, {"c": 3}}, merged(godash.WithSliceStrategy(godash.SliceAppend)))
+		assert.Equal(t, []map[string]int{{"a": 1, "c": 3}, {"b": 2}}, merged(godash.WithSliceStrategy(godash.SliceMergeByIndex)))
+	})
+
+	t.Run("should skip zero values unless asked to overwrite", func(t *testing.T) {
+		src := appConfig{Name: "", Debug: false}
+
+		{
+			dst := appConfig{Name: "app", Debug: true}
+			err := godash.Merge(&dst, src)
+			assert.NoError(t, err)
+			assert.Equal(t, appConfig{Name: "app", Debug: true}, dst)
+		}
+		{
+			dst := appConfig{Name: "app", Debug: true}
+			err := godash.Merge(&dst, godash.OverwriteWithZero(), src)
+			assert.NoError(t, err)
+			assert.Equal(t, appConfig{}, dst)
+		}
+	})
+
+	t.Run("should not add map keys whose values are skipped", func(t *testing.T) {
+		{
+			dst := map[string]int{"a": 1, "c": 3}
+			err := godash.Merge(&dst, map[string]int{"b": 0, "c": 0})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]int{"a": 1, "c": 3}, dst)
+		}
+		{
+			dst := map[string]interface{}{"a": 1}
+			err := godash.Merge(&dst, map[string]interface{}{"b": nil})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"a": 1}, dst)
+		}
+		{
+			dst := map[string]int{"a": 1, "c": 3}
+			err := godash.Merge(&dst, godash.OverwriteWithZero(), map[string]int{"b": 0, "c": 0})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]int{"a": 1, "b": 0, "c": 0}, dst)
+		}
+	})
+
+	t.Run("should merge cyclic values", func(t *testing.T) {
+		{
+			src := &node{Value: 1}
+			src.Next = src
+			dst := &node{}
+			dst.Next = dst
+
+			err := godash.Merge(&dst, src)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, dst.Value)
+			assert.True(t, dst.Next == dst)
+		}
+		{
+			src := map[string]interface{}{"name": "src"}
+			src["self"] = src
+			dst := map[string]interface{}{}
+			dst["self"] = dst
+
+			err := godash.Merge(&dst, src)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "src", dst["name"])
+			assert.Equal(t, "src", dst["self"].(map[string]interface{})["name"])
+		}
+		{
+			src := []*node{{Value: 1}}
+			src[0].Children = src
+			dst := []*node{{}}
+			dst[0].Children = dst
+
+			err := godash.Merge(&dst, src, godash.WithSliceStrategy(godash.SliceMergeByIndex))
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, dst[0].Value)
+		}
+	})
+
+	t.Run("should validate types", func(t *testing.T) {
+		{
+			dst := appConfig{}
+			err := godash.Merge(&dst, database{})
+			assert.EqualError(t, err, "source(godash_test.database) has to be the same Type as dst(godash_test.appConfig)")
+		}
+		{
+			dst := map[string]interface{}{"a": map[string]interface{}{}}
+			err := godash.Merge(&dst, map[string]interface{}{"a": map[string]int{"b": 1}})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"a": map[string]int{"b": 1}}, dst)
+		}
+		{
+			err := godash.Merge(appConfig{}, appConfig{})
+			assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+		}
+	})
+}
+
+func TestMergeWith(t *testing.T) {
+	t.Run("should use customizer for values of its type", func(t *testing.T) {
+		dst := appConfig{Name: "app", Plugins: []string{"auth"}}
+		src := appConfig{Name: "api", Plugins: []string{"metrics"}}
+
+		err := godash.MergeWith(&dst, func(dst, src string) (string, bool) {
+			return dst + "-" + src, true
+		}, src, godash.WithSliceStrategy(godash.SliceMergeByIndex))
+
+		assert.NoError(t, err)
+		assert.Equal(t, appConfig{Name: "app-api", Plugins: []string{"auth-metrics"}}, dst)
+	})
+
+	t.Run("should fall back to merge when customizer returns false", func(t *testing.T) {
+		dst := map[string]string{"a": "1", "b": "2"}
+
+		err := godash.MergeWith(&dst, func(dst, src string) (string, bool) {
+			return strings.ToUpper(src), src == "x"
+		}, map[string]string{"a": "x", "b": "y"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "X", "b": "y"}, dst)
+	})
+
+	t.Run("should validate customizer", func(t *testing.T) {
+		var dst appConfig
+
+		{
+			err := godash.MergeWith(&dst, "not a func", dst)
+			assert.EqualError(t, err, "customizerFn has to be a function")
+		}
+		{
+			err := godash.MergeWith(&dst, func(string, int) (string, bool) { return "", false }, dst)
+			assert.EqualError(t, err, "customizer function has to take exactly two arguments of the same type")
+		}
+		{
+			err := godash.MergeWith(&dst, func(string, string) string { return "" }, dst)
+			assert.EqualError(t, err, "customizer function should return a (string) and a (bool)")
+		}
+	})
+}
+
+func TestDefaults(t *testing.T) {
+	dst := appConfig{Name: "app", Database: &database{Port: 6543}, Plugins: []string{"auth"}}
+	defaults := appConfig{Name: "default", Debug: true, Database: &database{Host: "localhost", Port: 5432}, Plugins: []string{"metrics"}}
+	fallback := appConfig{Extra: map[string]interface{}{"region": "local"}, Database: &database{Host: "remote"}}
+
+	err := godash.Defaults(&dst, defaults, fallback)
+
+	expected := appConfig{
+		Name:     "app",
+		Debug:    true,
+		Database: &database{Host: "localhost", Port: 6543},
+		Plugins:  []string{"auth"},
+		Extra:    map[string]interface{}{"region": "local"},
+	}
+	assert.NoError(t, err)
+	assert.Equal(t, expected, dst)
+}
+
+func ExampleMerge() {
+	type config struct {
+		Host string
+		Port int
+		Tags map[string]string
+	}
+	defaults := config{Host: "localhost", Port: 8080, Tags: map[string]string{"env": "dev"}}
+	fromFile := config{Port: 9090, Tags: map[string]string{"team": "core"}}
+	output := config{}
+
+	_ = godash.Merge(&output, defaults, fromFile)
+
+	fmt.Println(output)
+
+	// Output: {localhost 9090 map[env:dev team:core]}
+}
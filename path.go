@@ -0,0 +1,364 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// PathError is returned when a path cannot be resolved because it does not match the types along the way.
+// Path is the complete path, Segment is the field, key or index at which resolving failed and
+// Reason describes the mismatch.
+type PathError struct {
+	Path    string
+	Segment string
+	Reason  string
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("path (%s) at (%s): %s", e.Path, e.Segment, e.Reason)
+}
+
+func newPathError(path string, segment pathSegment, format string, args ...interface{}) *PathError {
+	return &PathError{Path: path, Segment: segment.String(), Reason: fmt.Sprintf(format, args...)}
+}
+
+// pathSegment is either a field or key name, or an index of a path like "a.b[0].c".
+type pathSegment struct {
+	name    string
+	index   int
+	isIndex bool
+}
+
+func (s pathSegment) String() string {
+	if s.isIndex {
+		return fmt.Sprintf("[%d]", s.index)
+	}
+	return s.name
+}
+
+// parsePath splits a path like "Address.Lines[0]" into its segments.
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	for _, part := range strings.Split(path, ".") {
+		name := part
+		if bracket := strings.Index(part, "["); bracket >= 0 {
+			name = part[:bracket]
+		}
+		if name != "" {
+			segments = append(segments, pathSegment{name: name})
+		}
+
+		rest := part[len(name):]
+		if name == "" && rest == "" {
+			return nil, &PathError{Path: path, Segment: part, Reason: "empty segment"}
+		}
+		for rest != "" {
+			end := strings.Index(rest, "]")
+			if rest[0] != '[' || end < 0 {
+				return nil, &PathError{Path: path, Segment: part, Reason: "invalid segment"}
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, &PathError{Path: path, Segment: rest[:end+1], Reason: "invalid index"}
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			rest = rest[end+1:]
+		}
+	}
+	return segments, nil
+}
+
+// structField looks up a field of the struct type t by its name or by the name in its json tag.
+func structField(t reflect.Type, name string) (reflect.StructField, bool) {
+	if field, ok := t.FieldByName(name); ok {
+		return field, true
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if jsonName := strings.Split(field.Tag.Get("json"), ",")[0]; jsonName == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func lookupField(t reflect.Type, path string, segment pathSegment) (reflect.StructField, error) {
+	field, ok := structField(t, segment.name)
+	if !ok {
+		return field, newPathError(path, segment, "field not found in (%s)", t)
+	}
+	if field.PkgPath != "" {
+		return field, newPathError(path, segment, "field is not exported in (%s)", t)
+	}
+	return field, nil
+}
+
+func lookupKey(t reflect.Type, path string, segment pathSegment) error {
+	if segment.isIndex {
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return newPathError(path, segment, "index cannot be looked up in (%s)", t)
+		}
+		return nil
+	}
+	if t.Kind() == reflect.Map && t.Key().Kind() != reflect.String {
+		return newPathError(path, segment, "key cannot be looked up in (%s)", t)
+	}
+	if t.Kind() != reflect.Map && t.Kind() != reflect.Struct {
+		return newPathError(path, segment, "field cannot be looked up in (%s)", t)
+	}
+	return nil
+}
+
+// pathType resolves the type found at path when starting from a value of type t.
+// Once an interface is reached, the rest of the path can only be resolved at runtime
+// and hence the interface type is returned.
+func pathType(t reflect.Type, path string) (reflect.Type, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, segment := range segments {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Interface {
+			return t, nil
+		}
+		if err := lookupKey(t, path, segment); err != nil {
+			return nil, err
+		}
+
+		if t.Kind() == reflect.Struct {
+			field, err := lookupField(t, path, segment)
+			if err != nil {
+				return nil, err
+			}
+			t = field.Type
+		} else {
+			t = t.Elem()
+		}
+	}
+
+	return t, nil
+}
+
+// pathValue returns the value found at path in v.
+// ok is false if the path does not exist in v or does not match the types in v.
+func pathValue(v reflect.Value, path string) (value reflect.Value, ok bool) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return reflect.Value{}, false
+	}
+	value, ok, err = lookupPath(v, path, segments)
+	return value, ok && err == nil
+}
+
+// lookupPath returns the value found at path in v.
+// found is false if a map key or slice index along the path does not exist or a nil pointer is reached.
+// A *PathError is returned if the path does not match the types in v.
+func lookupPath(v reflect.Value, path string, segments []pathSegment) (value reflect.Value, found bool, err error) {
+	for _, segment := range segments {
+		if v = indirect(v); !v.IsValid() {
+			return reflect.Value{}, false, nil
+		}
+		if err := lookupKey(v.Type(), path, segment); err != nil {
+			return reflect.Value{}, false, err
+		}
+
+		switch {
+		case segment.isIndex:
+			if segment.index >= v.Len() {
+				return reflect.Value{}, false, nil
+			}
+			v = v.Index(segment.index)
+		case v.Kind() == reflect.Struct:
+			field, err := lookupField(v.Type(), path, segment)
+			if err != nil {
+				return reflect.Value{}, false, err
+			}
+			if v, found = fieldByIndex(v, field.Index); !found {
+				return reflect.Value{}, false, nil
+			}
+			if !v.CanInterface() {
+				return reflect.Value{}, false, newPathError(path, segment, "field is not exported in (%s)", field.Type)
+			}
+		default:
+			if v = v.MapIndex(reflect.ValueOf(segment.name).Convert(v.Type().Key())); !v.IsValid() {
+				return reflect.Value{}, false, nil
+			}
+		}
+	}
+
+	return v, true, nil
+}
+
+// updatePath returns v with value set at path, allocating nil pointers, maps and slices on the way.
+// If unset is true, the key is deleted instead when the path ends at a map.
+// Values that are not addressable, like structs stored in maps, are copied and the copy is returned.
+func updatePath(v reflect.Value, path string, segments []pathSegment, value reflect.Value, unset bool) (reflect.Value, error) {
+	if len(segments) == 0 {
+		return assignable(value, v.Type(), path)
+	}
+
+	segment := segments[0]
+	switch v.Kind() {
+	case reflect.Ptr:
+		target := v
+		if target.IsNil() {
+			target = reflect.New(v.Type().Elem())
+		}
+		updated, err := updatePath(target.Elem(), path, segments, value, unset)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		target.Elem().Set(updated)
+		return target, nil
+	case reflect.Interface:
+		var current reflect.Value
+		switch {
+		case !v.IsNil():
+			current = v.Elem()
+		case v.NumMethod() > 0:
+			return reflect.Value{}, newPathError(path, segment, "cannot allocate a value for (%s)", v.Type())
+		case segment.isIndex:
+			current = reflect.ValueOf([]interface{}{})
+		default:
+			current = reflect.ValueOf(map[string]interface{}{})
+		}
+		return updatePath(addressableCopy(current), path, segments, value, unset)
+	}
+
+	if err := lookupKey(v.Type(), path, segment); err != nil {
+		return reflect.Value{}, err
+	}
+
+	var target reflect.Value
+	switch {
+	case segment.isIndex && v.Kind() == reflect.Array:
+		if segment.index >= v.Len() {
+			return reflect.Value{}, newPathError(path, segment, "index out of range for (%s)", v.Type())
+		}
+		v = addressableCopy(v)
+		target = v.Index(segment.index)
+	case segment.isIndex:
+		for v.Len() <= segment.index {
+			v = reflect.Append(v, reflect.Zero(v.Type().Elem()))
+		}
+		target = v.Index(segment.index)
+	case v.Kind() == reflect.Struct:
+		field, err := lookupField(v.Type(), path, segment)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = addressableCopy(v)
+		target = v
+		for i, fieldIndex := range field.Index {
+			if i > 0 && target.Kind() == reflect.Ptr {
+				if target.IsNil() {
+					if !target.CanSet() {
+						return reflect.Value{}, newPathError(path, segment, "embedded (%s) is nil and cannot be allocated in (%s)", target.Type(), v.Type())
+					}
+					target.Set(reflect.New(target.Type().Elem()))
+				}
+				target = target.Elem()
+			}
+			target = target.Field(fieldIndex)
+		}
+		if !target.CanSet() {
+			return reflect.Value{}, newPathError(path, segment, "field is not exported in (%s)", v.Type())
+		}
+	default:
+		if v.IsNil() {
+			v = reflect.MakeMap(v.Type())
+		}
+		key := reflect.ValueOf(segment.name).Convert(v.Type().Key())
+		if unset && len(segments) == 1 {
+			v.SetMapIndex(key, reflect.Value{})
+			return v, nil
+		}
+		current := v.MapIndex(key)
+		if !current.IsValid() {
+			current = reflect.Zero(v.Type().Elem())
+		}
+		updated, err := updatePath(addressableCopy(current), path, segments[1:], value, unset)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.SetMapIndex(key, updated)
+		return v, nil
+	}
+
+	updated, err := updatePath(target, path, segments[1:], value, unset)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	target.Set(updated)
+	return v, nil
+}
+
+// assignable returns value in a form that can be assigned to a value of type t.
+// Numbers are converted between numeric types as long as they fit in t without losing a fraction,
+// and an invalid value is treated as the zero value of t.
+func assignable(value reflect.Value, t reflect.Type, path string) (reflect.Value, error) {
+	if !value.IsValid() {
+		return reflect.Zero(t), nil
+	}
+	if value.Kind() == reflect.Interface && t.Kind() != reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Type().AssignableTo(t) {
+		return value, nil
+	}
+
+	segment := pathSegment{name: path}
+	if segments, err := parsePath(path); err == nil && len(segments) > 0 {
+		segment = segments[len(segments)-1]
+	}
+	if isNumber(value.Kind()) && isNumber(t.Kind()) {
+		result, ok := convertNumber(value, t)
+		if !ok {
+			return reflect.Value{}, newPathError(path, segment, "value (%v) cannot be converted to (%s) exactly", value, t)
+		}
+		return result, nil
+	}
+	return reflect.Value{}, newPathError(path, segment, "value of type (%s) cannot be assigned to (%s)", value.Type(), t)
+}
+
+func addressableCopy(v reflect.Value) reflect.Value {
+	if v.CanSet() {
+		return v
+	}
+	value := reflect.New(v.Type()).Elem()
+	value.Set(v)
+	return value
+}
+
+// indirect dereferences pointers and interfaces until a concrete value is reached.
+// It returns the zero reflect.Value if a nil pointer or interface is found on the way.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// fieldByIndex is like reflect.Value.FieldByIndex but does not panic on nil embedded pointers.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 {
+			if v = indirect(v); !v.IsValid() {
+				return reflect.Value{}, false
+			}
+		}
+		v = v.Field(fieldIndex)
+	}
+	return v, true
+}
@@ -0,0 +1,107 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Pick sets in out only the given fields of obj.
+//
+// Obj can be a struct, a pointer to a struct or a map with string keys.
+// Fields of structs are matched by field name or json tag, and fields of embedded structs are matched
+// as if they were fields of obj. Keys of maps are matched as is.
+//
+// Out can be a reference to a map with string keys, in which case the json tag (or field name) is used as key,
+// or a reference to a struct, in which case fields are set by name. Fields that out does not have, or cannot set
+// like fields promoted through a nil pointer to an unexported embedded struct, are skipped.
+//
+// Validations:
+//
+//	1. Obj should be a struct or a map with string keys
+//	2. Out should be a reference to a struct or a map with string keys
+//	3. Picked values should be assignable to out's fields or elements. Numbers are converted if they fit exactly.
+//
+// Type mismatches are returned as *PathError and validation errors are returned to the caller.
+func Pick(obj, out interface{}, fields ...string) error {
+	return pick(obj, out, fields, true)
+}
+
+// Omit is the opposite of Pick. It sets in out all fields of obj except the given fields.
+func Omit(obj, out interface{}, fields ...string) error {
+	return pick(obj, out, fields, false)
+}
+
+type pickedEntry struct {
+	key   string
+	name  string
+	value reflect.Value
+}
+
+func pick(obj, out interface{}, fields []string, keep bool) error {
+	input := indirect(reflect.ValueOf(obj))
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	selected := map[string]bool{}
+	for _, field := range fields {
+		selected[field] = true
+	}
+
+	var entries []pickedEntry
+	switch {
+	case input.Kind() == reflect.Struct:
+		for _, entry := range structEntries(input) {
+			if (selected[entry.key] || selected[entry.field.Name]) == keep {
+				entries = append(entries, pickedEntry{key: entry.key, name: entry.field.Name, value: entry.value})
+			}
+		}
+	case input.Kind() == reflect.Map && input.Type().Key().Kind() == reflect.String:
+		for _, key := range input.MapKeys() {
+			if selected[key.String()] == keep {
+				entries = append(entries, pickedEntry{key: key.String(), name: key.String(), value: input.MapIndex(key)})
+			}
+		}
+	default:
+		return fmt.Errorf("obj has to be a struct or a map with string keys")
+	}
+
+	outputType := output.Elem().Type()
+	result := reflect.New(outputType).Elem()
+	switch {
+	case outputType.Kind() == reflect.Map && outputType.Key().Kind() == reflect.String:
+		result = reflect.MakeMapWithSize(outputType, len(entries))
+		for _, entry := range entries {
+			value, err := assignable(entry.value, outputType.Elem(), entry.key)
+			if err != nil {
+				return err
+			}
+			result.SetMapIndex(reflect.ValueOf(entry.key).Convert(outputType.Key()), value)
+		}
+	case outputType.Kind() == reflect.Struct:
+		for _, entry := range entries {
+			name := entry.name
+			field, ok := structField(outputType, name)
+			if !ok || field.PkgPath != "" {
+				if field, ok = structField(outputType, entry.key); !ok || field.PkgPath != "" {
+					continue
+				}
+				name = entry.key
+			}
+			if _, ok := settableFieldByIndex(result, field.Index); !ok {
+				continue
+			}
+			updated, err := updatePath(result, name, []pathSegment{{name: name}}, entry.value, false)
+			if err != nil {
+				return err
+			}
+			result.Set(updated)
+		}
+	default:
+		return fmt.Errorf("output has to be a reference to a struct or a map with string keys and not (%s)", outputType)
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type profile struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type publicProfile struct {
+	ID   int64
+	Name string
+}
+
+func TestPick(t *testing.T) {
+	in := profile{ID: 1, Name: "John", Email: "john@example.com"}
+
+	t.Run("should pick struct fields into a map by field name or json tag", func(t *testing.T) {
+		var out map[string]interface{}
+
+		err := godash.Pick(in, &out, "ID", "name")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"id": 1, "name": "John"}, out)
+	})
+
+	t.Run("should pick struct fields into a struct with matching fields", func(t *testing.T) {
+		var out publicProfile
+
+		err := godash.Pick(&in, &out, "id", "Name", "email")
+
+		assert.NoError(t, err)
+		assert.Equal(t, publicProfile{ID: 1, Name: "John"}, out)
+	})
+
+	t.Run("should pick map keys", func(t *testing.T) {
+		var out map[string]int
+
+		err := godash.Pick(map[string]int{"a": 1, "b": 2, "c": 3}, &out, "a", "c", "d")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "c": 3}, out)
+	})
+
+	t.Run("should pick map keys into a struct", func(t *testing.T) {
+		var out profile
+
+		err := godash.Pick(map[string]interface{}{"name": "John", "email": "john@example.com"}, &out, "name")
+
+		assert.NoError(t, err)
+		assert.Equal(t, profile{Name: "John"}, out)
+	})
+
+	t.Run("should skip fields promoted through nil unexported embedded pointers", func(t *testing.T) {
+		type inner struct {
+			X int
+		}
+		type outer struct {
+			*inner
+			Y int
+		}
+		var out outer
+
+		err := godash.Pick(map[string]interface{}{"X": 5, "Y": 6}, &out, "X", "Y")
+
+		assert.NoError(t, err)
+		assert.Equal(t, outer{Y: 6}, out)
+	})
+
+	t.Run("should return path errors on type mismatches", func(t *testing.T) {
+		var out map[string]string
+
+		err := godash.Pick(in, &out, "id")
+
+		assert.EqualError(t, err, "path (id) at (id): value of type (int) cannot be assigned to (string)")
+	})
+
+	t.Run("should validate obj and out", func(t *testing.T) {
+		{
+			var out map[string]interface{}
+			err := godash.Pick([]int{1}, &out)
+			assert.EqualError(t, err, "obj has to be a struct or a map with string keys")
+		}
+		{
+			var out []int
+			err := godash.Pick(in, &out)
+			assert.EqualError(t, err, "output has to be a reference to a struct or a map with string keys and not ([]int)")
+		}
+		{
+			err := godash.Pick(in, nil)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+	})
+}
+
+func TestOmit(t *testing.T) {
+	in := profile{ID: 1, Name: "John", Email: "john@example.com"}
+
+	t.Run("should omit struct fields", func(t *testing.T) {
+		var out map[string]interface{}
+
+		err := godash.Omit(in, &out, "Email")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"id": 1, "name": "John"}, out)
+	})
+
+	t.Run("should omit map keys", func(t *testing.T) {
+		var out map[string]int
+
+		err := godash.Omit(map[string]int{"a": 1, "b": 2}, &out, "a")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"b": 2}, out)
+	})
+}
+
+func ExamplePick() {
+	type user struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	input := user{Name: "John", Email: "john@example.com", Password: "secret"}
+	var output map[string]interface{}
+
+	_ = godash.Pick(input, &output, "name", "email")
+
+	fmt.Println(output)
+
+	// Output: map[email:john@example.com name:John]
+}
+
+func ExampleOmit() {
+	input := map[string]string{"name": "John", "password": "secret"}
+	var output map[string]string
+
+	_ = godash.Omit(input, &output, "password")
+
+	fmt.Println(output)
+
+	// Output: map[name:John]
+}
@@ -0,0 +1,97 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Not sets out to a predicate that negates predicateFn.
+// Instead of a function, predicateFn can also be a Matcher created by Matches or MatchesProperty.
+//
+// Validations:
+//
+//	1. Out should be a reference to a predicate function of the form func(T) bool
+//	2. Predicate function should take one argument of type T and return a bool
+//
+// Validation errors are returned to the caller.
+func Not(predicateFn, out interface{}) error {
+	output, err := predicateOut(out)
+	if err != nil {
+		return err
+	}
+	predicate, err := validatePredicate(output.Type().In(0), predicateFn)
+	if err != nil {
+		return err
+	}
+
+	output.Set(reflect.MakeFunc(output.Type(), func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(!predicate.Call(args)[0].Bool()).Convert(output.Type().Out(0))}
+	}))
+
+	return nil
+}
+
+// Negate is an alias for Not function
+func Negate(predicateFn, out interface{}) error {
+	return Not(predicateFn, out)
+}
+
+// And sets out to a predicate that passes if all predicates pass.
+// Predicates are called in order and the first one that fails stops the rest from being called.
+// Instead of functions, predicates can also be Matchers created by Matches or MatchesProperty.
+//
+// Validations:
+//
+//	1. Out should be a reference to a predicate function of the form func(T) bool
+//	2. Predicate functions should take one argument of type T and return a bool
+//
+// Validation errors are returned to the caller.
+func And(out interface{}, predicateFns ...interface{}) error {
+	return combinePredicates(out, predicateFns, false)
+}
+
+// Or sets out to a predicate that passes if any of predicates passes.
+// Predicates are called in order and the first one that passes stops the rest from being called.
+// It is validated like And.
+func Or(out interface{}, predicateFns ...interface{}) error {
+	return combinePredicates(out, predicateFns, true)
+}
+
+func combinePredicates(out interface{}, predicateFns []interface{}, stopAt bool) error {
+	output, err := predicateOut(out)
+	if err != nil {
+		return err
+	}
+	predicates := make([]reflect.Value, len(predicateFns))
+	for i, predicateFn := range predicateFns {
+		if predicates[i], err = validatePredicate(output.Type().In(0), predicateFn); err != nil {
+			return fmt.Errorf("predicateFns[%d]: %s", i, err)
+		}
+	}
+
+	output.Set(reflect.MakeFunc(output.Type(), func(args []reflect.Value) []reflect.Value {
+		for _, predicate := range predicates {
+			if predicate.Call(args)[0].Bool() == stopAt {
+				return []reflect.Value{reflect.ValueOf(stopAt).Convert(output.Type().Out(0))}
+			}
+		}
+		return []reflect.Value{reflect.ValueOf(!stopAt).Convert(output.Type().Out(0))}
+	}))
+
+	return nil
+}
+
+// predicateOut validates that out is a reference to a predicate function and returns what it points to.
+func predicateOut(out interface{}) (reflect.Value, error) {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return output, err
+	}
+
+	outputType := output.Elem().Type()
+	if outputType.Kind() != reflect.Func || outputType.NumIn() != 1 || outputType.IsVariadic() ||
+		outputType.NumOut() != 1 || outputType.Out(0).Kind() != reflect.Bool {
+		return output, fmt.Errorf("out (%s) has to be a predicate function of the form func(T) bool", outputType)
+	}
+	return output.Elem(), nil
+}
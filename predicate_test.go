@@ -0,0 +1,141 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestNot(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	t.Run("should negate the predicate", func(t *testing.T) {
+		var isOdd func(int) bool
+
+		err := godash.Not(isEven, &isOdd)
+
+		assert.NoError(t, err)
+		assert.True(t, isOdd(3))
+		assert.False(t, isOdd(4))
+	})
+
+	t.Run("should negate matchers", func(t *testing.T) {
+		type user struct {
+			Active bool
+		}
+		var inactive func(user) bool
+		var out []user
+
+		assert.NoError(t, godash.Negate(godash.MatchesProperty("Active", true), &inactive))
+		assert.NoError(t, godash.Filter([]user{{true}, {false}}, &out, inactive))
+
+		assert.Equal(t, []user{{false}}, out)
+	})
+
+	t.Run("should validate predicate and output", func(t *testing.T) {
+		{
+			var out func(int) int
+			err := godash.Not(isEven, &out)
+			assert.EqualError(t, err, "out (func(int) int) has to be a predicate function of the form func(T) bool")
+		}
+		{
+			var out func(string) bool
+			err := godash.Not(isEven, &out)
+			assert.EqualError(t, err, "predicate function's first argument has to be the type (string) instead of (int)")
+		}
+		{
+			var out func(int) bool
+			err := godash.Not(isEven, out)
+			assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+		}
+	})
+}
+
+func TestAndOr(t *testing.T) {
+	var calls []string
+	positive := func(n int) bool {
+		calls = append(calls, "positive")
+		return n > 0
+	}
+	even := func(n int) bool {
+		calls = append(calls, "even")
+		return n%2 == 0
+	}
+
+	t.Run("should pass if all predicates pass with And", func(t *testing.T) {
+		var positiveAndEven func(int) bool
+		assert.NoError(t, godash.And(&positiveAndEven, positive, even))
+
+		calls = nil
+		assert.True(t, positiveAndEven(2))
+		assert.False(t, positiveAndEven(3))
+		assert.Equal(t, []string{"positive", "even", "positive", "even"}, calls)
+
+		calls = nil
+		assert.False(t, positiveAndEven(-2))
+		assert.Equal(t, []string{"positive"}, calls, "should stop at the first failing predicate")
+	})
+
+	t.Run("should pass if any predicate passes with Or", func(t *testing.T) {
+		var positiveOrEven func(int) bool
+		assert.NoError(t, godash.Or(&positiveOrEven, positive, even))
+
+		calls = nil
+		assert.True(t, positiveOrEven(-2))
+		assert.False(t, positiveOrEven(-3))
+
+		calls = nil
+		assert.True(t, positiveOrEven(1))
+		assert.Equal(t, []string{"positive"}, calls, "should stop at the first passing predicate")
+	})
+
+	t.Run("should handle no predicates", func(t *testing.T) {
+		var and, or func(int) bool
+
+		assert.NoError(t, godash.And(&and))
+		assert.NoError(t, godash.Or(&or))
+
+		assert.True(t, and(1))
+		assert.False(t, or(1))
+	})
+
+	t.Run("should validate that predicates share the argument type", func(t *testing.T) {
+		var out func(int) bool
+		{
+			err := godash.And(&out, positive, func(s string) bool { return s != "" })
+			assert.EqualError(t, err, "predicateFns[1]: predicate function's first argument has to be the type (int) instead of (string)")
+		}
+		{
+			err := godash.Or(&out, "positive")
+			assert.EqualError(t, err, "predicateFns[0]: predicateFn has to be a function")
+		}
+	})
+}
+
+func ExampleAnd() {
+	var isSmallEven func(int) bool
+	_ = godash.And(&isSmallEven,
+		func(n int) bool { return n%2 == 0 },
+		func(n int) bool { return n < 5 },
+	)
+
+	var out []int
+	_ = godash.Filter([]int{1, 2, 3, 4, 5, 6}, &out, isSmallEven)
+
+	fmt.Println(out)
+
+	// Output: [2 4]
+}
+
+func ExampleNot() {
+	var isOdd func(int) bool
+	_ = godash.Not(func(n int) bool { return n%2 == 0 }, &isOdd)
+
+	allOdd, _ := godash.All([]int{1, 3, 5}, isOdd)
+
+	fmt.Println(allOdd)
+
+	// Output: true
+}
@@ -0,0 +1,190 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Range sets out to the numbers from start up to, but not including, end, stepping by step.
+// A negative step counts down from start to end, and out is set to an empty slice if step
+// does not go from start towards end.
+//
+// Out can be a slice of any kind of number, and start, end and step are converted to its element type.
+// Float elements are computed as start + i*step, rounded to the decimal places of start and step,
+// so that Range(0, 1, 0.1, &out) results in 0.3 rather than 0.30000000000000004 and does not include 1.
+//
+// Validations:
+//
+//	1. Output should be a reference to a slice of numbers
+//	2. Start, end and step should be numbers, and integers for a slice of integers
+//	3. Step should not be zero, and for floats start, end and step should be finite
+//	4. All the elements should fit in the element type of output
+//
+// Validation errors are returned to the caller.
+func Range(start, end, step, out interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice || !isNumber(output.Elem().Type().Elem().Kind()) {
+		return fmt.Errorf("output (%s) has to be a slice of numbers", output.Elem().Type())
+	}
+
+	numbers, err := numberRange(start, end, step, output.Elem().Type().Elem())
+	if err != nil {
+		return err
+	}
+	numbers.collect(output.Elem())
+
+	return nil
+}
+
+// RangeIterator is the lazy form of Range. It returns an Iterator of the numbers from start up to,
+// but not including, end, stepping by step, which are of the type of start. It is validated like Range.
+func RangeIterator(start, end, step interface{}) (Iterator, error) {
+	startValue := reflect.ValueOf(start)
+	if !startValue.IsValid() || !isNumber(startValue.Kind()) {
+		return nil, fmt.Errorf("start (%v) has to be a number", start)
+	}
+	return numberRange(start, end, step, startValue.Type())
+}
+
+// numberRange returns a generator of the numbers of elemType from start to end, stepping by step.
+func numberRange(start, end, step interface{}, elemType reflect.Type) (*generator, error) {
+	bounds := []interface{}{start, end, step}
+	values := make([]reflect.Value, len(bounds))
+	isInteger := isSigned(elemType.Kind()) || isUnsigned(elemType.Kind())
+	for i, name := range []string{"start", "end", "step"} {
+		values[i] = reflect.ValueOf(bounds[i])
+		if !values[i].IsValid() || !isNumber(values[i].Kind()) {
+			return nil, fmt.Errorf("%s (%v) has to be a number", name, bounds[i])
+		}
+		if isInteger && !isSigned(values[i].Kind()) && !isUnsigned(values[i].Kind()) {
+			return nil, fmt.Errorf("%s (%v) has to be an integer for elements of type (%s)", name, bounds[i], elemType)
+		}
+	}
+
+	if isInteger {
+		return integerRange(values[0], values[1], values[2], elemType)
+	}
+	return floatRange(values[0], values[1], values[2], elemType)
+}
+
+func integerRange(startValue, endValue, stepValue reflect.Value, elemType reflect.Type) (*generator, error) {
+	start, end, step := bigInt(startValue), bigInt(endValue), bigInt(stepValue)
+	if step.Sign() == 0 {
+		return nil, fmt.Errorf("step cannot be zero")
+	}
+
+	count := new(big.Int)
+	if distance := new(big.Int).Sub(end, start); distance.Sign() == step.Sign() {
+		// count = ceil(distance / step), where both are of the same sign
+		count.Add(distance, step).Sub(count, big.NewInt(int64(step.Sign())))
+		count.Quo(count, step)
+	}
+	if !count.IsInt64() {
+		return nil, fmt.Errorf("range from (%s) to (%s) by (%s) has too many elements", start, end, step)
+	}
+
+	if count.Sign() > 0 {
+		last := new(big.Int).Mul(new(big.Int).Sub(count, big.NewInt(1)), step)
+		last.Add(last, start)
+		for _, bound := range []*big.Int{start, last} {
+			if !fitsIn(bound, elemType) {
+				return nil, fmt.Errorf("element (%s) does not fit in (%s)", bound, elemType)
+			}
+		}
+	}
+
+	// elements are computed modulo 2^64, which gives them exactly as they all fit in elemType
+	startBits, stepBits := lowBits(start), lowBits(step)
+	return &generator{elemType: elemType, count: count.Int64(), at: func(index int64) reflect.Value {
+		bits := startBits + uint64(index)*stepBits
+		element := reflect.New(elemType).Elem()
+		if isSigned(elemType.Kind()) {
+			element.SetInt(int64(bits))
+		} else {
+			element.SetUint(bits)
+		}
+		return element
+	}}, nil
+}
+
+func floatRange(startValue, endValue, stepValue reflect.Value, elemType reflect.Type) (*generator, error) {
+	start, end, step := toFloat(startValue), toFloat(endValue), toFloat(stepValue)
+	for _, bound := range []float64{start, end, step} {
+		if math.IsNaN(bound) || math.IsInf(bound, 0) {
+			return nil, fmt.Errorf("start, end and step have to be finite")
+		}
+	}
+	if step == 0 {
+		return nil, fmt.Errorf("step cannot be zero")
+	}
+
+	scale := math.Pow10(decimalPlaces(startValue))
+	if places := decimalPlaces(stepValue); places > decimalPlaces(startValue) {
+		scale = math.Pow10(places)
+	}
+	at := func(index int64) float64 {
+		element := start + float64(index)*step
+		if scaled := element * scale; scale <= 1e15 && math.Abs(scaled) < 1<<53 {
+			element = math.Round(scaled) / scale
+		}
+		return element
+	}
+	before := func(element float64) bool {
+		return step > 0 && element < end || step < 0 && element > end
+	}
+
+	estimate := math.Ceil((end - start) / step)
+	if estimate >= math.MaxInt64 {
+		return nil, fmt.Errorf("range from (%v) to (%v) by (%v) has too many elements", start, end, step)
+	}
+	count := int64(math.Max(estimate, 0))
+	for count > 0 && !before(at(count-1)) {
+		count--
+	}
+	for before(at(count)) {
+		count++
+	}
+
+	return &generator{elemType: elemType, count: count, at: func(index int64) reflect.Value {
+		return reflect.ValueOf(at(index)).Convert(elemType)
+	}}, nil
+}
+
+func bigInt(value reflect.Value) *big.Int {
+	if isSigned(value.Kind()) {
+		return big.NewInt(value.Int())
+	}
+	return new(big.Int).SetUint64(value.Uint())
+}
+
+// lowBits returns the lowest 64 bits of the two's complement of n.
+func lowBits(n *big.Int) uint64 {
+	return new(big.Int).And(n, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+}
+
+func fitsIn(n *big.Int, elemType reflect.Type) bool {
+	element := reflect.New(elemType).Elem()
+	if isSigned(elemType.Kind()) {
+		return n.IsInt64() && !element.OverflowInt(n.Int64())
+	}
+	return n.IsUint64() && !element.OverflowUint(n.Uint64())
+}
+
+// decimalPlaces returns the number of digits after the decimal point in the shortest representation of number.
+func decimalPlaces(number reflect.Value) int {
+	if number.Kind() != reflect.Float32 && number.Kind() != reflect.Float64 {
+		return 0
+	}
+	formatted := strconv.FormatFloat(number.Float(), 'f', -1, number.Type().Bits())
+	if point := strings.IndexByte(formatted, '.'); point >= 0 {
+		return len(formatted) - point - 1
+	}
+	return 0
+}
@@ -0,0 +1,187 @@
+package godash_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("should set the integers from start up to end", func(t *testing.T) {
+		cases := []struct {
+			start, end, step interface{}
+			expected         []int
+		}{
+			{0, 5, 1, []int{0, 1, 2, 3, 4}},
+			{1, 10, 3, []int{1, 4, 7}},
+			{1, 11, 5, []int{1, 6}},
+			{5, 0, -2, []int{5, 3, 1}},
+			{-3, 0, 1, []int{-3, -2, -1}},
+			{0, 5, -1, []int{}},
+			{3, 3, 1, []int{}},
+			{int8(0), uint64(3), int16(1), []int{0, 1, 2}},
+		}
+
+		for _, c := range cases {
+			var out []int
+
+			err := godash.Range(c.start, c.end, c.step, &out)
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, out, "Range(%v, %v, %v)", c.start, c.end, c.step)
+		}
+	})
+
+	t.Run("should set numbers of any kind", func(t *testing.T) {
+		var bytes []uint8
+		err := godash.Range(250, 256, 2, &bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint8{250, 252, 254}, bytes)
+
+		var countdown []uint
+		err = godash.Range(3, -1, -1, &countdown)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{3, 2, 1, 0}, countdown)
+
+		var extremes []int64
+		err = godash.Range(int64(math.MinInt64), int64(math.MaxInt64), uint64(math.MaxUint64-1), &extremes)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{math.MinInt64, math.MaxInt64 - 1}, extremes)
+	})
+
+	t.Run("should round floats to the decimal places of start and step", func(t *testing.T) {
+		var out []float64
+		err := godash.Range(0, 1, 0.1, &out)
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{0, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}, out)
+
+		err = godash.Range(1.5, 0, -0.25, &out)
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{1.5, 1.25, 1, 0.75, 0.5, 0.25}, out)
+
+		var singles []float32
+		err = godash.Range(float32(0.1), 0.5, float32(0.1), &singles)
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{0.1, 0.2, 0.3, 0.4}, singles)
+	})
+
+	t.Run("should validate the output", func(t *testing.T) {
+		var out []string
+		err := godash.Range(0, 5, 1, &out)
+		assert.EqualError(t, err, "output ([]string) has to be a slice of numbers")
+
+		var notSlice int
+		err = godash.Range(0, 5, 1, &notSlice)
+		assert.EqualError(t, err, "output (int) has to be a slice of numbers")
+
+		err = godash.Range(0, 5, 1, []int{})
+		assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+	})
+
+	t.Run("should validate start, end and step", func(t *testing.T) {
+		var out []int
+		err := godash.Range("0", 5, 1, &out)
+		assert.EqualError(t, err, "start (0) has to be a number")
+
+		err = godash.Range(0, nil, 1, &out)
+		assert.EqualError(t, err, "end (<nil>) has to be a number")
+
+		err = godash.Range(0, 5, 0.5, &out)
+		assert.EqualError(t, err, "step (0.5) has to be an integer for elements of type (int)")
+
+		err = godash.Range(0, 5, 0, &out)
+		assert.EqualError(t, err, "step cannot be zero")
+
+		var floats []float64
+		err = godash.Range(0, math.Inf(1), 1, &floats)
+		assert.EqualError(t, err, "start, end and step have to be finite")
+
+		err = godash.Range(0.0, 1, 0.0, &floats)
+		assert.EqualError(t, err, "step cannot be zero")
+	})
+
+	t.Run("should validate the elements fit in the output", func(t *testing.T) {
+		var bytes []uint8
+		err := godash.Range(250, 300, 10, &bytes)
+		assert.EqualError(t, err, "element (290) does not fit in (uint8)")
+
+		var unsigned []uint
+		err = godash.Range(-1, 3, 1, &unsigned)
+		assert.EqualError(t, err, "element (-1) does not fit in (uint)")
+	})
+}
+
+func TestRangeIterator(t *testing.T) {
+	t.Run("should read the numbers lazily", func(t *testing.T) {
+		numbers, err := godash.RangeIterator(0, 1000000000, 3)
+		assert.NoError(t, err)
+
+		var out []int
+		err = godash.Take(numbers, &out, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 3, 6}, out)
+
+		err = godash.Take(numbers, &out, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{9, 12}, out)
+	})
+
+	t.Run("should have elements of the type of start", func(t *testing.T) {
+		numbers, err := godash.RangeIterator(uint16(5), 0, -2)
+		assert.NoError(t, err)
+
+		var out []uint16
+		err = godash.Collect(numbers, &out)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint16{5, 3, 1}, out)
+	})
+
+	t.Run("should be usable with functions taking functions of its elements even if empty", func(t *testing.T) {
+		numbers, err := godash.RangeIterator(0.5, 0, 1)
+		assert.NoError(t, err)
+
+		var out []string
+		err = godash.Map(numbers, &out, func(n float64) string { return fmt.Sprint(n) })
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, out)
+	})
+
+	t.Run("should validate start, end and step", func(t *testing.T) {
+		_, err := godash.RangeIterator(nil, 5, 1)
+		assert.EqualError(t, err, "start (<nil>) has to be a number")
+
+		_, err = godash.RangeIterator(0, 5, 0)
+		assert.EqualError(t, err, "step cannot be zero")
+	})
+}
+
+func ExampleRange() {
+	var evens []int
+	var tenths []float64
+	_ = godash.Range(10, 0, -2, &evens)
+	_ = godash.Range(0, 0.5, 0.1, &tenths)
+
+	fmt.Println(evens)
+	fmt.Println(tenths)
+
+	// Output:
+	// [10 8 6 4 2]
+	// [0 0.1 0.2 0.3 0.4]
+}
+
+func ExampleRangeIterator() {
+	numbers, _ := godash.RangeIterator(1, math.MaxInt64, 1)
+
+	_ = godash.ForEach(numbers, func(n int) bool {
+		fmt.Println(n)
+		return n < 3
+	})
+
+	// Output:
+	// 1
+	// 2
+	// 3
+}
@@ -23,11 +23,14 @@ import (
 //
 // Validation errors are returned to the caller.
 func Reduce(in, out, reduceFn interface{}) error {
-	input := reflect.ValueOf(in)
 	output := reflect.ValueOf(out)
 	if err := isReferenceType(output); err != nil {
 		return err
 	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
 
 	reducer := reflect.ValueOf(reduceFn)
 	if err := validateReducer(reducer); err != nil {
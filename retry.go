@@ -0,0 +1,217 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backoff returns how long to wait before the given retry, which starts at 1 for the first retry.
+type Backoff func(retry int) time.Duration
+
+// ConstantBackoff waits delay before every retry.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// ExponentialBackoff waits initial before the first retry and doubles the wait for every retry after it,
+// up to max. A max of zero or less does not limit the wait, which then stops growing at the longest
+// time.Duration instead of overflowing.
+func ExponentialBackoff(initial, max time.Duration) Backoff {
+	return func(retry int) time.Duration {
+		delay := initial
+		for i := 1; i < retry; i++ {
+			if delay > math.MaxInt64/2 {
+				delay = math.MaxInt64
+				break
+			}
+			if delay *= 2; max > 0 && delay >= max {
+				return max
+			}
+		}
+		if max > 0 && delay > max {
+			return max
+		}
+		return delay
+	}
+}
+
+// Jitter randomizes the waits of backoff to anywhere between zero and the wait, so that
+// many callers retrying at the same time spread their retries out.
+// Random numbers are taken from random, which can be seeded for reproducible waits, or from
+// the default source of math/rand if random is nil.
+func Jitter(backoff Backoff, random *rand.Rand) Backoff {
+	var mutex sync.Mutex
+	return func(retry int) time.Duration {
+		delay := backoff(retry)
+		if delay <= 0 {
+			return delay
+		}
+		// The wait itself is included unless it is the longest time.Duration, where one more would overflow.
+		n := int64(delay)
+		if n < math.MaxInt64 {
+			n++
+		}
+		if random == nil {
+			return time.Duration(rand.Int63n(n))
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		return time.Duration(random.Int63n(n))
+	}
+}
+
+// RetryPolicy decides how Retry and MapRetry retry a function that returns an error.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times the function is called at most, including the first call.
+	// Values less than 1 are treated as 1.
+	MaxAttempts int
+	// Backoff is how long to wait before each retry. Retries are not delayed if it is nil.
+	Backoff Backoff
+	// Retryable reports whether a call that returned err should be retried. All errors are retried if it is nil.
+	Retryable func(err error) bool
+	// Clock is used to wait between retries. The system clock is used if it is nil.
+	Clock Clock
+}
+
+// call calls function with args until it does not return an error, the error is not retryable
+// or MaxAttempts is reached. It returns the results of the last call and the number of calls made.
+func (p RetryPolicy) call(function reflect.Value, args []reflect.Value) ([]reflect.Value, int) {
+	clock := p.Clock
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	for attempt := 1; ; attempt++ {
+		results := callFn(function, args)
+		err, _ := results[len(results)-1].Interface().(error)
+		if err == nil || attempt >= p.MaxAttempts || p.Retryable != nil && !p.Retryable(err) {
+			return results, attempt
+		}
+		if p.Backoff != nil {
+			clock.Sleep(p.Backoff(attempt))
+		}
+	}
+}
+
+// Retry sets wrapped to a function that calls fn and retries it as per policy when it returns an error.
+// The wrapped function returns the results of the last call to fn.
+//
+// Validations:
+//
+//	1. Fn should be a function that returns an error as its last return value
+//	2. Wrapped should be a reference to a function of the same type as fn
+//
+// Validation errors are returned to the caller.
+func Retry(fn, wrapped interface{}, policy RetryPolicy) error {
+	function, output, err := validateWrapped(fn, wrapped)
+	if err != nil {
+		return err
+	}
+	if fnType := function.Type(); fnType.NumOut() == 0 || fnType.Out(fnType.NumOut()-1) != errorType {
+		return fmt.Errorf("fn should return an (error) as its last return value")
+	}
+
+	output.Set(reflect.MakeFunc(function.Type(), func(args []reflect.Value) []reflect.Value {
+		results, _ := policy.call(function, args)
+		return results
+	}))
+
+	return nil
+}
+
+// ElementError is the error mapping the element at Index of the input failed with.
+type ElementError struct {
+	Index    int
+	Element  interface{}
+	Attempts int
+	Err      error
+}
+
+func (e ElementError) Error() string {
+	return fmt.Sprintf("element at (%d) failed after %d attempt(s): %s", e.Index, e.Attempts, e.Err)
+}
+
+func (e ElementError) Unwrap() error {
+	return e.Err
+}
+
+// MapRetryError is returned by MapRetry when mapping some of the elements failed even after retrying.
+type MapRetryError struct {
+	Errors []ElementError
+}
+
+func (e *MapRetryError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("mapping failed for %d element(s): %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// MapRetry is like Map except that mapperFn can fail, in which case it is retried as per policy.
+// MapperFn is of the form func(T) (U, error).
+//
+// All elements are mapped even if some of them fail. Out is set with the results of all elements,
+// with zero values for the failed ones, and a *MapRetryError listing the failed elements is returned.
+//
+// Validations:
+//
+//	1. Input should be a slice or an array and output should be a reference to a slice
+//	2. Mapper function should take one argument of input's element type
+//	3. Mapper function should return a value of output's element type and an error
+//
+// Validation errors are returned to the caller.
+func MapRetry(in, out, mapperFn interface{}, policy RetryPolicy) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	if input.Kind() != reflect.Slice && input.Kind() != reflect.Array {
+		return fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+	if output.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("output should be a slice for input of type %s", input.Kind())
+	}
+
+	mapper := reflect.ValueOf(mapperFn)
+	if mapper.Kind() != reflect.Func {
+		return fmt.Errorf("mapperFn has to be a function")
+	}
+	mapperFnType := mapper.Type()
+	if mapperFnType.NumIn() != 1 || mapperFnType.In(0) != input.Type().Elem() {
+		return fmt.Errorf("mapper function has to take only one argument of type (%s)", input.Type().Elem())
+	}
+	outputElemType := output.Elem().Type().Elem()
+	if mapperFnType.NumOut() != 2 || mapperFnType.Out(0) != outputElemType || mapperFnType.Out(1) != errorType {
+		return fmt.Errorf("mapper function should return a (%s) and an (error)", outputElemType)
+	}
+
+	var failures []ElementError
+	result := reflect.MakeSlice(output.Elem().Type(), input.Len(), input.Len())
+	for i := 0; i < input.Len(); i++ {
+		element := input.Index(i)
+		results, attempts := policy.call(mapper, []reflect.Value{element})
+		if err, _ := results[1].Interface().(error); err != nil {
+			failures = append(failures, ElementError{Index: i, Element: element.Interface(), Attempts: attempts, Err: err})
+			continue
+		}
+		result.Index(i).Set(results[0])
+	}
+	output.Elem().Set(result)
+
+	if len(failures) > 0 {
+		return &MapRetryError{Errors: failures}
+	}
+	return nil
+}
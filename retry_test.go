@@ -0,0 +1,303 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestRetry(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+
+	flaky := func(failures int) (*int, func(string) (int, error)) {
+		calls := 0
+		return &calls, func(value string) (int, error) {
+			calls++
+			if calls <= failures {
+				return 0, errUnavailable
+			}
+			return len(value), nil
+		}
+	}
+
+	t.Run("should retry until fn does not return an error", func(t *testing.T) {
+		clock := newFakeClock()
+		start := clock.Now()
+		calls, fn := flaky(2)
+		var retried func(string) (int, error)
+
+		err := godash.Retry(fn, &retried, godash.RetryPolicy{MaxAttempts: 5, Backoff: godash.ConstantBackoff(time.Second), Clock: clock})
+		assert.NoError(t, err)
+
+		length, err := retried("godash")
+		assert.NoError(t, err)
+		assert.Equal(t, 6, length)
+		assert.Equal(t, 3, *calls)
+		assert.Equal(t, 2*time.Second, clock.Now().Sub(start))
+	})
+
+	t.Run("should return the results of the last attempt when attempts are exhausted", func(t *testing.T) {
+		clock := newFakeClock()
+		start := clock.Now()
+		calls, fn := flaky(10)
+		var retried func(string) (int, error)
+
+		err := godash.Retry(fn, &retried, godash.RetryPolicy{MaxAttempts: 4, Backoff: godash.ExponentialBackoff(time.Second, 0), Clock: clock})
+		assert.NoError(t, err)
+
+		_, err = retried("godash")
+		assert.Equal(t, errUnavailable, err)
+		assert.Equal(t, 4, *calls)
+		assert.Equal(t, (1+2+4)*time.Second, clock.Now().Sub(start))
+	})
+
+	t.Run("should not retry errors that are not retryable", func(t *testing.T) {
+		calls, fn := flaky(10)
+		var retried func(string) (int, error)
+
+		err := godash.Retry(fn, &retried, godash.RetryPolicy{
+			MaxAttempts: 4,
+			Clock:       newFakeClock(),
+			Retryable: func(err error) bool {
+				return !errors.Is(err, errUnavailable)
+			},
+		})
+		assert.NoError(t, err)
+
+		_, err = retried("godash")
+		assert.Equal(t, errUnavailable, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("should call fn once if max attempts is not set", func(t *testing.T) {
+		calls, fn := flaky(10)
+		var retried func(string) (int, error)
+
+		err := godash.Retry(fn, &retried, godash.RetryPolicy{})
+		assert.NoError(t, err)
+
+		_, err = retried("godash")
+		assert.Error(t, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("should retry variadic functions", func(t *testing.T) {
+		calls := 0
+		fn := func(values ...int) error {
+			calls++
+			if calls < 2 {
+				return errUnavailable
+			}
+			return nil
+		}
+		var retried func(...int) error
+
+		err := godash.Retry(fn, &retried, godash.RetryPolicy{MaxAttempts: 3, Clock: newFakeClock()})
+		assert.NoError(t, err)
+
+		assert.NoError(t, retried(1, 2, 3))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should validate fn returns an error as its last value", func(t *testing.T) {
+		var retried func(string) int
+		err := godash.Retry(func(string) int { return 0 }, &retried, godash.RetryPolicy{})
+		assert.EqualError(t, err, "fn should return an (error) as its last return value")
+
+		var noResults func()
+		err = godash.Retry(func() {}, &noResults, godash.RetryPolicy{})
+		assert.EqualError(t, err, "fn should return an (error) as its last return value")
+	})
+
+	t.Run("should validate wrapped is of the type of fn", func(t *testing.T) {
+		_, fn := flaky(0)
+		var retried func(int) (int, error)
+		err := godash.Retry(fn, &retried, godash.RetryPolicy{})
+		assert.EqualError(t, err, "wrapped (func(int) (int, error)) should be of the same Type as fn (func(string) (int, error))")
+
+		err = godash.Retry("fn", &retried, godash.RetryPolicy{})
+		assert.EqualError(t, err, "fn has to be a function")
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Run("should wait the same for every retry with ConstantBackoff", func(t *testing.T) {
+		backoff := godash.ConstantBackoff(time.Second)
+		assert.Equal(t, time.Second, backoff(1))
+		assert.Equal(t, time.Second, backoff(5))
+	})
+
+	t.Run("should double the wait for every retry with ExponentialBackoff", func(t *testing.T) {
+		backoff := godash.ExponentialBackoff(100*time.Millisecond, time.Second)
+		assert.Equal(t, 100*time.Millisecond, backoff(1))
+		assert.Equal(t, 200*time.Millisecond, backoff(2))
+		assert.Equal(t, 800*time.Millisecond, backoff(4))
+		assert.Equal(t, time.Second, backoff(5))
+		assert.Equal(t, time.Second, backoff(100))
+	})
+
+	t.Run("should not overflow when ExponentialBackoff is not limited", func(t *testing.T) {
+		backoff := godash.ExponentialBackoff(time.Second, 0)
+		assert.Equal(t, time.Duration(1<<33)*time.Second, backoff(34))
+		assert.Equal(t, time.Duration(math.MaxInt64), backoff(35))
+		assert.Equal(t, time.Duration(math.MaxInt64), backoff(200))
+
+		limited := godash.ExponentialBackoff(time.Second, time.Duration(math.MaxInt64))
+		assert.Equal(t, time.Duration(math.MaxInt64), limited(200))
+	})
+
+	t.Run("should wait up to the wait of backoff with Jitter", func(t *testing.T) {
+		backoff := godash.Jitter(godash.ConstantBackoff(time.Second), rand.New(rand.NewSource(1)))
+		for retry := 1; retry <= 100; retry++ {
+			delay := backoff(retry)
+			assert.True(t, delay >= 0 && delay <= time.Second, "delay %s is out of range", delay)
+		}
+	})
+
+	t.Run("should not overflow with Jitter when the wait is the longest duration", func(t *testing.T) {
+		random := rand.New(rand.NewSource(1))
+		for _, backoff := range []godash.Backoff{
+			godash.Jitter(godash.ExponentialBackoff(time.Second, 0), random),
+			godash.Jitter(godash.ConstantBackoff(math.MaxInt64), random),
+			godash.Jitter(godash.ConstantBackoff(math.MaxInt64), nil),
+		} {
+			assert.True(t, backoff(40) >= 0)
+		}
+	})
+
+	t.Run("should wait the same with Jitter for the same seed", func(t *testing.T) {
+		first := godash.Jitter(godash.ConstantBackoff(time.Second), rand.New(rand.NewSource(42)))
+		second := godash.Jitter(godash.ConstantBackoff(time.Second), rand.New(rand.NewSource(42)))
+		for retry := 1; retry <= 10; retry++ {
+			assert.Equal(t, first(retry), second(retry))
+		}
+	})
+}
+
+func TestMapRetry(t *testing.T) {
+	t.Run("should map all elements retrying the failed ones", func(t *testing.T) {
+		attempts := map[string]int{}
+		var out []int
+
+		err := godash.MapRetry([]string{"1", "2", "3"}, &out, func(value string) (int, error) {
+			attempts[value]++
+			if value == "2" && attempts[value] < 3 {
+				return 0, errors.New("unavailable")
+			}
+			return strconv.Atoi(value)
+		}, godash.RetryPolicy{MaxAttempts: 3, Clock: newFakeClock()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, out)
+		assert.Equal(t, map[string]int{"1": 1, "2": 3, "3": 1}, attempts)
+	})
+
+	t.Run("should report the elements whose retries were exhausted", func(t *testing.T) {
+		clock := newFakeClock()
+		start := clock.Now()
+		var out []int
+
+		err := godash.MapRetry([]string{"1", "two", "3", "four"}, &out, strconv.Atoi,
+			godash.RetryPolicy{MaxAttempts: 2, Backoff: godash.ConstantBackoff(time.Second), Clock: clock})
+
+		var mapRetryErr *godash.MapRetryError
+		assert.True(t, errors.As(err, &mapRetryErr))
+		assert.Len(t, mapRetryErr.Errors, 2)
+		assert.Equal(t, 1, mapRetryErr.Errors[0].Index)
+		assert.Equal(t, "two", mapRetryErr.Errors[0].Element)
+		assert.Equal(t, 2, mapRetryErr.Errors[0].Attempts)
+		assert.Equal(t, 3, mapRetryErr.Errors[1].Index)
+		assert.True(t, errors.Is(mapRetryErr.Errors[1], strconv.ErrSyntax))
+		assert.EqualError(t, err, `mapping failed for 2 element(s): `+
+			`element at (1) failed after 2 attempt(s): strconv.Atoi: parsing "two": invalid syntax; `+
+			`element at (3) failed after 2 attempt(s): strconv.Atoi: parsing "four": invalid syntax`)
+		assert.Equal(t, []int{1, 0, 3, 0}, out)
+		assert.Equal(t, 2*time.Second, clock.Now().Sub(start))
+	})
+
+	t.Run("should stop retrying an element when its error is not retryable", func(t *testing.T) {
+		var out []int
+		err := godash.MapRetry([]string{"x"}, &out, strconv.Atoi, godash.RetryPolicy{
+			MaxAttempts: 5,
+			Clock:       newFakeClock(),
+			Retryable: func(err error) bool {
+				return !errors.Is(err, strconv.ErrSyntax)
+			},
+		})
+
+		var mapRetryErr *godash.MapRetryError
+		assert.True(t, errors.As(err, &mapRetryErr))
+		assert.Equal(t, 1, mapRetryErr.Errors[0].Attempts)
+	})
+
+	t.Run("should map arrays", func(t *testing.T) {
+		var out []int
+		err := godash.MapRetry([2]string{"4", "5"}, &out, strconv.Atoi, godash.RetryPolicy{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 5}, out)
+	})
+
+	t.Run("should validate the input, output and mapper function", func(t *testing.T) {
+		var out []int
+		err := godash.MapRetry(map[string]string{}, &out, strconv.Atoi, godash.RetryPolicy{})
+		assert.EqualError(t, err, "not implemented for (map)")
+
+		var notSlice int
+		err = godash.MapRetry([]string{}, &notSlice, strconv.Atoi, godash.RetryPolicy{})
+		assert.EqualError(t, err, "output should be a slice for input of type slice")
+
+		err = godash.MapRetry([]string{}, []int{}, strconv.Atoi, godash.RetryPolicy{})
+		assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+
+		err = godash.MapRetry([]string{}, &out, "mapper", godash.RetryPolicy{})
+		assert.EqualError(t, err, "mapperFn has to be a function")
+
+		err = godash.MapRetry([]int{}, &out, strconv.Atoi, godash.RetryPolicy{})
+		assert.EqualError(t, err, "mapper function has to take only one argument of type (int)")
+
+		err = godash.MapRetry([]string{}, &out, func(string) int { return 0 }, godash.RetryPolicy{})
+		assert.EqualError(t, err, "mapper function should return a (int) and an (error)")
+	})
+}
+
+func ExampleRetry() {
+	calls := 0
+	fetch := func(id int) (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errors.New("unavailable")
+		}
+		return fmt.Sprintf("user-%d", id), nil
+	}
+
+	var fetchWithRetry func(int) (string, error)
+	_ = godash.Retry(fetch, &fetchWithRetry, godash.RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     godash.Jitter(godash.ExponentialBackoff(time.Millisecond, 10*time.Millisecond), nil),
+	})
+
+	user, err := fetchWithRetry(42)
+	fmt.Println(user, err, calls)
+
+	// Output: user-42 <nil> 3
+}
+
+func ExampleMapRetry() {
+	var out []int
+	err := godash.MapRetry([]string{"1", "two", "3"}, &out, strconv.Atoi, godash.RetryPolicy{MaxAttempts: 2})
+
+	fmt.Println(out)
+	fmt.Println(err)
+
+	// Output:
+	// [1 0 3]
+	// mapping failed for 1 element(s): element at (1) failed after 2 attempt(s): strconv.Atoi: parsing "two": invalid syntax
+}
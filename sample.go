@@ -0,0 +1,360 @@
+package godash
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+)
+
+// SampleOption configures the source of randomness of Sample, SampleSize, Shuffle and their weighted forms.
+type SampleOption func(*sampleOptions)
+
+type sampleOptions struct {
+	random *rand.Rand
+}
+
+// WithRand sets the source of random numbers. As a *rand.Rand is not safe for concurrent use,
+// it should not be shared by concurrent calls. The default source of math/rand is used by default.
+func WithRand(random *rand.Rand) SampleOption {
+	return func(options *sampleOptions) {
+		options.random = random
+	}
+}
+
+// WithSeed makes the sampling reproducible by using a new source of random numbers seeded with seed.
+func WithSeed(seed int64) SampleOption {
+	return WithRand(rand.New(rand.NewSource(seed)))
+}
+
+func newSampleOptions(opts []SampleOption) sampleOptions {
+	options := sampleOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+func (o sampleOptions) intn(n int) int {
+	if o.random == nil {
+		return rand.Intn(n)
+	}
+	return o.random.Intn(n)
+}
+
+func (o sampleOptions) float64() float64 {
+	if o.random == nil {
+		return rand.Float64()
+	}
+	return o.random.Float64()
+}
+
+// Sample sets out to a random element of in.
+//
+// Input can be a slice, an array, a map, a channel or an Iterator. The values of maps are sampled,
+// in the order of their keys if they are of an ordered kind, so that sampling with a seed is reproducible.
+// Channels and Iterators are read until they are exhausted, keeping only the sampled element.
+// An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array, a map, a channel or an Iterator
+//	2. Output should be a reference to a value of input's element type
+//
+// Validation errors are returned to the caller.
+func Sample(in, out interface{}, opts ...SampleOption) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	elemType, elements, recv, err := sampleSource(in)
+	if err != nil {
+		return err
+	}
+	if output.Elem().Type() != elemType {
+		return fmt.Errorf("output (%s) has to be of the element type of input (%s)", output.Elem().Type(), elemType)
+	}
+	options := newSampleOptions(opts)
+
+	var sampled reflect.Value
+	if recv == nil {
+		if elements.Len() > 0 {
+			sampled = elements.Index(options.intn(elements.Len()))
+		}
+	} else {
+		// reservoir sampling of a single element
+		for count := 1; ; count++ {
+			element, ok, err := recv()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			if options.intn(count) == 0 {
+				sampled = element
+			}
+		}
+	}
+	if !sampled.IsValid() {
+		return &AggregateError{Func: "Sample", Err: ErrEmpty}
+	}
+	output.Elem().Set(sampled)
+
+	return nil
+}
+
+// SampleSize sets out to n random elements of in, in a random order, or to all of them if in has fewer elements.
+// Elements are sampled without replacement, so an element is sampled at most once. A negative n is treated as zero.
+//
+// Input can be of the kinds Sample takes. Channels and Iterators are read until they are exhausted,
+// keeping at most n elements with reservoir sampling, so that inputs of unknown length can be sampled.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array, a map, a channel or an Iterator
+//	2. Output should be a reference to a slice of input's element type
+//
+// Validation errors are returned to the caller.
+func SampleSize(in, out interface{}, n int, opts ...SampleOption) error {
+	if n < 0 {
+		n = 0
+	}
+	return sampleSize(in, out, n, opts)
+}
+
+// Shuffle sets out to the elements of in in a random order. It is validated like SampleSize.
+func Shuffle(in, out interface{}, opts ...SampleOption) error {
+	return sampleSize(in, out, int(^uint(0)>>1), opts)
+}
+
+// SampleWeighted sets out to a random element of in, where the chance of an element being sampled
+// is proportional to its weight as returned by weightFn.
+//
+// WeightFn is of the form func(T) N, where N is any kind of number, or a path like Get accepts which is
+// resolved against each element. Elements with a weight of zero are never sampled, and an *AggregateError
+// with ErrEmpty is returned if no element has a positive weight. Input can be of the kinds Sample takes.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array, a map, a channel or an Iterator
+//	2. Output should be a reference to a value of input's element type
+//	3. Weight function should take one argument of input's element type and return a number
+//	4. Weights should not be negative
+//
+// Validation errors are returned to the caller.
+func SampleWeighted(in, out, weightFn interface{}, opts ...SampleOption) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	sampled, elemType, err := sampleWeighted(in, weightFn, 1, opts)
+	if err != nil {
+		return err
+	}
+	if output.Elem().Type() != elemType {
+		return fmt.Errorf("output (%s) has to be of the element type of input (%s)", output.Elem().Type(), elemType)
+	}
+	if len(sampled) == 0 {
+		return &AggregateError{Func: "SampleWeighted", Err: ErrEmpty}
+	}
+	output.Elem().Set(sampled[0])
+
+	return nil
+}
+
+// SampleSizeWeighted sets out to n random elements of in, sampled without replacement with chances
+// proportional to their weights as returned by weightFn. Elements are set in the order they would be
+// drawn in one by one, and out has fewer than n elements if fewer elements have a positive weight.
+// A negative n is treated as zero.
+//
+// Channels and Iterators are read until they are exhausted, keeping at most n elements.
+// It is validated like SampleWeighted, except that output should be a reference to a slice of input's element type.
+func SampleSizeWeighted(in, out interface{}, n int, weightFn interface{}, opts ...SampleOption) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if n < 0 {
+		n = 0
+	}
+	sampled, elemType, err := sampleWeighted(in, weightFn, n, opts)
+	if err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice || output.Elem().Type().Elem() != elemType {
+		return fmt.Errorf("output (%s) should be a slice of (%s)", output.Elem().Type(), elemType)
+	}
+
+	result := reflect.MakeSlice(output.Elem().Type(), len(sampled), len(sampled))
+	for i, element := range sampled {
+		result.Index(i).Set(element)
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+// sampleSource returns the type of the elements of in, along with the elements of slices, arrays and maps,
+// or a function receiving them one at a time for channels and Iterators.
+func sampleSource(in interface{}) (elemType reflect.Type, elements reflect.Value, recv func() (reflect.Value, bool, error), err error) {
+	input := reflect.ValueOf(in)
+	if iterator, ok := iteratorOf(input); ok {
+		return iterator.elemType, reflect.Value{}, iterator.recv, nil
+	}
+
+	switch input.Kind() {
+	case reflect.Slice, reflect.Array:
+		return input.Type().Elem(), input, nil, nil
+	case reflect.Map:
+		_, values, _, valueType, _ := entriesOf(in)
+		elements = reflect.MakeSlice(reflect.SliceOf(valueType), len(values), len(values))
+		for i, value := range values {
+			elements.Index(i).Set(value)
+		}
+		return valueType, elements, nil, nil
+	case reflect.Chan:
+		if input.Type().ChanDir()&reflect.RecvDir == 0 {
+			return nil, reflect.Value{}, nil, fmt.Errorf("input channel (%s) has to allow receiving", input.Type())
+		}
+		recv = func() (reflect.Value, bool, error) {
+			element, ok := input.Recv()
+			return element, ok, nil
+		}
+		return input.Type().Elem(), reflect.Value{}, recv, nil
+	}
+
+	return nil, reflect.Value{}, nil, fmt.Errorf("not implemented for (%s)", input.Kind())
+}
+
+func sampleSize(in, out interface{}, n int, opts []SampleOption) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	elemType, elements, recv, err := sampleSource(in)
+	if err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice || output.Elem().Type().Elem() != elemType {
+		return fmt.Errorf("output (%s) should be a slice of (%s)", output.Elem().Type(), elemType)
+	}
+	options := newSampleOptions(opts)
+
+	var result reflect.Value
+	if recv == nil {
+		result = reflect.MakeSlice(output.Elem().Type(), elements.Len(), elements.Len())
+		reflect.Copy(result, elements)
+	} else {
+		// reservoir sampling, where each element replaces a kept one with a chance of n/count
+		result = reflect.MakeSlice(output.Elem().Type(), 0, 0)
+		for count := 1; ; count++ {
+			element, ok, err := recv()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			if result.Len() < n {
+				result = reflect.Append(result, element)
+			} else if kept := options.intn(count); kept < n {
+				result.Index(kept).Set(element)
+			}
+		}
+	}
+
+	// partial Fisher-Yates shuffle, which randomizes the order of the first n elements
+	if n > result.Len() {
+		n = result.Len()
+	}
+	swap := reflect.Swapper(result.Interface())
+	for i := 0; i < n; i++ {
+		swap(i, i+options.intn(result.Len()-i))
+	}
+	output.Elem().Set(result.Slice(0, n))
+
+	return nil
+}
+
+// sampleWeighted returns up to n elements of in sampled with the weights returned by weightFn,
+// along with the type of the elements of in. It uses the A-Res algorithm of Efraimidis and Spirakis,
+// which keeps the n elements with the largest keys u^(1/weight), where u is a random number in (0, 1].
+func sampleWeighted(in, weightFn interface{}, n int, opts []SampleOption) ([]reflect.Value, reflect.Type, error) {
+	elemType, elements, recv, err := sampleSource(in)
+	if err != nil {
+		return nil, nil, err
+	}
+	if weightFn == nil {
+		return nil, nil, fmt.Errorf("weightFn has to be a function")
+	}
+	weight, err := keyFnFor(elemType, weightFn, isNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	options := newSampleOptions(opts)
+
+	if recv == nil {
+		index := 0
+		recv = func() (reflect.Value, bool, error) {
+			if index == elements.Len() {
+				return reflect.Value{}, false, nil
+			}
+			index++
+			return elements.Index(index - 1), true, nil
+		}
+	}
+
+	kept := &weightedElements{}
+	for index := 0; ; index++ {
+		element, ok, err := recv()
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			break
+		}
+
+		w := toFloat(weight.Call([]reflect.Value{element})[0])
+		if w < 0 || math.IsNaN(w) {
+			return nil, nil, fmt.Errorf("weight (%v) of element at (%d) cannot be negative", w, index)
+		}
+		if w == 0 || n == 0 {
+			continue
+		}
+		// log(u)/weight orders elements as u^(1/weight) does, without underflowing for small weights
+		key := math.Log(1-options.float64()) / w
+		if kept.Len() < n {
+			heap.Push(kept, weightedElement{element: element, key: key})
+		} else if key > (*kept)[0].key {
+			(*kept)[0] = weightedElement{element: element, key: key}
+			heap.Fix(kept, 0)
+		}
+	}
+
+	sampled := make([]reflect.Value, kept.Len())
+	for i := len(sampled) - 1; i >= 0; i-- {
+		sampled[i] = heap.Pop(kept).(weightedElement).element
+	}
+	return sampled, elemType, nil
+}
+
+type weightedElement struct {
+	element reflect.Value
+	key     float64
+}
+
+// weightedElements is a min-heap of elements by their keys.
+type weightedElements []weightedElement
+
+func (h weightedElements) Len() int            { return len(h) }
+func (h weightedElements) Less(i, j int) bool  { return h[i].key < h[j].key }
+func (h weightedElements) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *weightedElements) Push(x interface{}) { *h = append(*h, x.(weightedElement)) }
+func (h *weightedElements) Pop() interface{} {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
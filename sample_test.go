@@ -0,0 +1,369 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestSample(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+
+	t.Run("should sample an element of a slice", func(t *testing.T) {
+		var out int
+		err := godash.Sample(in, &out)
+
+		assert.NoError(t, err)
+		assert.Contains(t, in, out)
+	})
+
+	t.Run("should sample the same element for the same seed", func(t *testing.T) {
+		for seed := int64(0); seed < 10; seed++ {
+			var first, second int
+			assert.NoError(t, godash.Sample(in, &first, godash.WithSeed(seed)))
+			assert.NoError(t, godash.Sample(in, &second, godash.WithRand(rand.New(rand.NewSource(seed)))))
+			assert.Equal(t, first, second)
+		}
+	})
+
+	t.Run("should sample every element of a channel with the same chance", func(t *testing.T) {
+		random := rand.New(rand.NewSource(1))
+		counts := map[int]int{}
+		for i := 0; i < 5000; i++ {
+			ch := make(chan int, len(in))
+			for _, n := range in {
+				ch <- n
+			}
+			close(ch)
+
+			var out int
+			assert.NoError(t, godash.Sample(ch, &out, godash.WithRand(random)))
+			counts[out]++
+		}
+
+		for _, n := range in {
+			assert.InDelta(t, 1000, counts[n], 150, "element %d", n)
+		}
+	})
+
+	t.Run("should sample values of maps and elements of arrays and iterators", func(t *testing.T) {
+		var value string
+		err := godash.Sample(map[int]string{1: "a", 2: "b"}, &value)
+		assert.NoError(t, err)
+		assert.Contains(t, []string{"a", "b"}, value)
+
+		var element int
+		err = godash.Sample([3]int{7, 7, 7}, &element)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, element)
+
+		numbers, _ := godash.RangeIterator(0, 10, 1)
+		err = godash.Sample(numbers, &element)
+		assert.NoError(t, err)
+		assert.True(t, element >= 0 && element < 10)
+	})
+
+	t.Run("should return an error for empty input", func(t *testing.T) {
+		var out int
+		err := godash.Sample([]int{}, &out)
+		assert.True(t, errors.Is(err, godash.ErrEmpty))
+		assert.EqualError(t, err, "cannot compute (Sample): input is empty")
+
+		ch := make(chan int)
+		close(ch)
+		err = godash.Sample(ch, &out)
+		assert.True(t, errors.Is(err, godash.ErrEmpty))
+	})
+
+	t.Run("should validate input and output", func(t *testing.T) {
+		var out string
+		err := godash.Sample(in, &out)
+		assert.EqualError(t, err, "output (string) has to be of the element type of input (int)")
+
+		var element int
+		err = godash.Sample("abc", &element)
+		assert.EqualError(t, err, "not implemented for (string)")
+
+		err = godash.Sample(make(chan<- int), &element)
+		assert.EqualError(t, err, "input channel (chan<- int) has to allow receiving")
+
+		err = godash.Sample(in, element)
+		assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+	})
+}
+
+func TestSampleSize(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	distinct := func(t *testing.T, out []int) {
+		seen := map[int]bool{}
+		for _, n := range out {
+			assert.False(t, seen[n], "%d is sampled more than once", n)
+			assert.Contains(t, in, n)
+			seen[n] = true
+		}
+	}
+
+	t.Run("should sample n distinct elements", func(t *testing.T) {
+		var out []int
+		err := godash.SampleSize(in, &out, 4, godash.WithSeed(42))
+
+		assert.NoError(t, err)
+		assert.Len(t, out, 4)
+		distinct(t, out)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, in, "input should not be modified")
+	})
+
+	t.Run("should sample all elements if in has fewer than n", func(t *testing.T) {
+		var out []int
+		err := godash.SampleSize(in, &out, 20)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, in, out)
+	})
+
+	t.Run("should treat a negative n as zero", func(t *testing.T) {
+		var out []int
+		err := godash.SampleSize(in, &out, -1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, out)
+	})
+
+	t.Run("should sample a channel of unknown length with reservoir sampling", func(t *testing.T) {
+		random := rand.New(rand.NewSource(7))
+		counts := map[int]int{}
+		for i := 0; i < 2000; i++ {
+			ch := make(chan int, len(in))
+			for _, n := range in {
+				ch <- n
+			}
+			close(ch)
+
+			var out []int
+			assert.NoError(t, godash.SampleSize(ch, &out, 3, godash.WithRand(random)))
+			assert.Len(t, out, 3)
+			distinct(t, out)
+			for _, n := range out {
+				counts[n]++
+			}
+		}
+
+		for _, n := range in {
+			assert.InDelta(t, 600, counts[n], 90, "element %d", n)
+		}
+	})
+
+	t.Run("should sample an iterator", func(t *testing.T) {
+		numbers, _ := godash.RangeIterator(1, 11, 1)
+
+		var out []int
+		err := godash.SampleSize(numbers, &out, 5, godash.WithSeed(3))
+
+		assert.NoError(t, err)
+		assert.Len(t, out, 5)
+		distinct(t, out)
+	})
+
+	t.Run("should validate output", func(t *testing.T) {
+		var out []string
+		err := godash.SampleSize(in, &out, 2)
+		assert.EqualError(t, err, "output ([]string) should be a slice of (int)")
+	})
+}
+
+func TestShuffle(t *testing.T) {
+	in := []string{"a", "b", "c", "d", "e", "f"}
+
+	t.Run("should shuffle all elements", func(t *testing.T) {
+		var out []string
+		err := godash.Shuffle(in, &out, godash.WithSeed(1))
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, in, out)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, in, "input should not be modified")
+	})
+
+	t.Run("should shuffle the same way for the same seed", func(t *testing.T) {
+		var first, second []string
+		assert.NoError(t, godash.Shuffle(in, &first, godash.WithSeed(5)))
+		assert.NoError(t, godash.Shuffle(in, &second, godash.WithSeed(5)))
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should shuffle values of maps in the same way for the same seed", func(t *testing.T) {
+		in := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+		var first, second []int
+		assert.NoError(t, godash.Shuffle(in, &first, godash.WithSeed(5)))
+		assert.NoError(t, godash.Shuffle(in, &second, godash.WithSeed(5)))
+
+		assert.ElementsMatch(t, []int{1, 2, 3, 4}, first)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should put every element in every position with the same chance", func(t *testing.T) {
+		random := rand.New(rand.NewSource(9))
+		firsts := map[string]int{}
+		for i := 0; i < 6000; i++ {
+			ch := make(chan string, len(in))
+			for _, s := range in {
+				ch <- s
+			}
+			close(ch)
+
+			var out []string
+			assert.NoError(t, godash.Shuffle(ch, &out, godash.WithRand(random)))
+			firsts[out[0]]++
+		}
+
+		for _, s := range in {
+			assert.InDelta(t, 1000, firsts[s], 150, "element %s", s)
+		}
+	})
+}
+
+func TestSampleWeighted(t *testing.T) {
+	type server struct {
+		Name   string
+		Weight int
+	}
+	servers := []server{{"a", 1}, {"b", 3}, {"c", 0}, {"d", 6}}
+
+	t.Run("should sample elements in proportion to their weights", func(t *testing.T) {
+		random := rand.New(rand.NewSource(11))
+		counts := map[string]int{}
+		for i := 0; i < 10000; i++ {
+			var out server
+			assert.NoError(t, godash.SampleWeighted(servers, &out, "Weight", godash.WithRand(random)))
+			counts[out.Name]++
+		}
+
+		assert.InDelta(t, 1000, counts["a"], 150)
+		assert.InDelta(t, 3000, counts["b"], 300)
+		assert.Equal(t, 0, counts["c"])
+		assert.InDelta(t, 6000, counts["d"], 300)
+	})
+
+	t.Run("should sample with a weight function and a channel", func(t *testing.T) {
+		ch := make(chan server, len(servers))
+		for _, s := range servers {
+			ch <- s
+		}
+		close(ch)
+
+		var out server
+		err := godash.SampleWeighted(ch, &out, func(s server) float64 { return float64(s.Weight) })
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, "c", out.Name)
+	})
+
+	t.Run("should return an error if no element has a positive weight", func(t *testing.T) {
+		var out server
+		err := godash.SampleWeighted([]server{{"c", 0}}, &out, "Weight")
+
+		assert.True(t, errors.Is(err, godash.ErrEmpty))
+		assert.EqualError(t, err, "cannot compute (SampleWeighted): input is empty")
+	})
+
+	t.Run("should validate weights and the weight function", func(t *testing.T) {
+		var out server
+		err := godash.SampleWeighted([]server{{"a", 1}, {"b", -1}}, &out, "Weight")
+		assert.EqualError(t, err, "weight (-1) of element at (1) cannot be negative")
+
+		err = godash.SampleWeighted(servers, &out, nil)
+		assert.EqualError(t, err, "weightFn has to be a function")
+
+		err = godash.SampleWeighted(servers, &out, func(s server) string { return s.Name })
+		assert.EqualError(t, err, "key function's return type (string) is not supported")
+
+		var name string
+		err = godash.SampleWeighted(servers, &name, "Weight")
+		assert.EqualError(t, err, "output (string) has to be of the element type of input (godash_test.server)")
+	})
+}
+
+func TestSampleSizeWeighted(t *testing.T) {
+	weights := map[string]int{"a": 1, "b": 2, "c": 0, "d": 10}
+	names := []string{"a", "b", "c", "d"}
+	weightOf := func(name string) int { return weights[name] }
+
+	t.Run("should sample distinct elements with a positive weight", func(t *testing.T) {
+		var out []string
+		err := godash.SampleSizeWeighted(names, &out, 10, weightOf, godash.WithSeed(1))
+
+		assert.NoError(t, err)
+		sorted := append([]string{}, out...)
+		sort.Strings(sorted)
+		assert.Equal(t, []string{"a", "b", "d"}, sorted)
+	})
+
+	t.Run("should draw heavier elements first more often", func(t *testing.T) {
+		random := rand.New(rand.NewSource(2))
+		firsts := map[string]int{}
+		for i := 0; i < 1300; i++ {
+			var out []string
+			assert.NoError(t, godash.SampleSizeWeighted(names, &out, 2, weightOf, godash.WithRand(random)))
+			assert.Len(t, out, 2)
+			firsts[out[0]]++
+		}
+
+		assert.InDelta(t, 100, firsts["a"], 40)
+		assert.InDelta(t, 200, firsts["b"], 60)
+		assert.InDelta(t, 1000, firsts["d"], 80)
+	})
+
+	t.Run("should treat a negative n as zero", func(t *testing.T) {
+		var out []string
+		err := godash.SampleSizeWeighted(names, &out, -1, weightOf)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, out)
+	})
+
+	t.Run("should validate output", func(t *testing.T) {
+		var out []int
+		err := godash.SampleSizeWeighted(names, &out, 1, weightOf)
+		assert.EqualError(t, err, "output ([]int) should be a slice of (string)")
+	})
+}
+
+func ExampleSampleSize() {
+	var canaries []string
+	_ = godash.SampleSize([]string{"host-1", "host-2", "host-3", "host-4"}, &canaries, 2, godash.WithSeed(1))
+
+	fmt.Println(canaries)
+
+	// Output: [host-2 host-1]
+}
+
+func ExampleShuffle() {
+	var deck []int
+	_ = godash.Shuffle([]int{1, 2, 3, 4, 5}, &deck, godash.WithSeed(7))
+
+	fmt.Println(deck)
+
+	// Output: [2 4 3 5 1]
+}
+
+func ExampleSampleWeighted() {
+	type variant struct {
+		Name    string
+		Traffic int
+	}
+	variants := []variant{{"control", 90}, {"experiment", 10}, {"disabled", 0}}
+
+	var picked variant
+	_ = godash.SampleWeighted(variants, &picked, "Traffic", godash.WithSeed(3))
+
+	fmt.Println(picked.Name != "disabled")
+
+	// Output: true
+}
@@ -0,0 +1,241 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SortedIndex returns the lowest index at which value should be inserted into in to keep it sorted,
+// which is found by binary search in O(log n) time.
+//
+// In should be sorted in ascending order, and the result is undefined otherwise.
+// Its elements should be of an ordered kind, that is numbers or strings, including named types based on them.
+//
+// Validations:
+//
+//	1. Input should be a slice or an array of an ordered kind
+//	2. Value should be of input's element type
+//
+// Validation errors are returned to the caller.
+func SortedIndex(in, value interface{}) (int, error) {
+	return sortedIndex(in, value, nil, false)
+}
+
+// SortedIndexBy is like SortedIndex except that in is sorted by the keys keyFn returns for its elements,
+// and keyFn is invoked for value too. KeyFn is of the form func(T) K, where K is an ordered kind,
+// or a path like Get accepts which is resolved against each element.
+func SortedIndexBy(in, value, keyFn interface{}) (int, error) {
+	return sortedIndex(in, value, keyFn, false)
+}
+
+// SortedLastIndex is like SortedIndex except that it returns the highest index at which value
+// should be inserted, which is after the elements equal to value.
+func SortedLastIndex(in, value interface{}) (int, error) {
+	return sortedIndex(in, value, nil, true)
+}
+
+// SortedLastIndexBy is like SortedIndexBy except that it returns the highest index at which value
+// should be inserted, which is after the elements with the same key as value.
+func SortedLastIndexBy(in, value, keyFn interface{}) (int, error) {
+	return sortedIndex(in, value, keyFn, true)
+}
+
+// BinarySearch searches for value in the sorted in, and returns the lowest index of value along with true
+// if it is found. Otherwise, it returns the index at which value should be inserted along with false.
+// It is validated like SortedIndex.
+func BinarySearch(in, value interface{}) (int, bool, error) {
+	return binarySearch(in, value, nil)
+}
+
+// BinarySearchBy is like BinarySearch except that in is sorted by the keys keyFn returns for its elements,
+// and the lowest index of an element with the same key as value is returned. KeyFn is as for SortedIndexBy.
+func BinarySearchBy(in, value, keyFn interface{}) (int, bool, error) {
+	return binarySearch(in, value, keyFn)
+}
+
+// SortedUniq sets out to the elements of the sorted in without duplicates, keeping the first of equal elements.
+// As in is sorted, equal elements are next to each other and are found in O(n) time.
+//
+// Validations:
+//
+//	1. Input should be a slice or an array of an ordered kind
+//	2. Output should be a reference to a slice of input's element type
+//
+// Validation errors are returned to the caller.
+func SortedUniq(in, out interface{}) error {
+	return sortedUniq(in, out, nil)
+}
+
+// SortedUniqBy is like SortedUniq except that in is sorted by the keys keyFn returns for its elements,
+// and elements with the same key are duplicates. KeyFn is as for SortedIndexBy.
+func SortedUniqBy(in, out, keyFn interface{}) error {
+	return sortedUniq(in, out, keyFn)
+}
+
+// InsertSorted sets out to the elements of the sorted in with value inserted so that it stays sorted.
+// Value is inserted after the elements equal to it. In is not modified, though out can be a reference to it.
+//
+// Validations:
+//
+//	1. Input should be a slice or an array of an ordered kind
+//	2. Value should be of input's element type
+//	3. Output should be a reference to a slice of input's element type
+//
+// Validation errors are returned to the caller.
+func InsertSorted(in, out, value interface{}) error {
+	return insertSorted(in, out, value, nil)
+}
+
+// InsertSortedBy is like InsertSorted except that in is sorted by the keys keyFn returns for its elements,
+// and value is inserted after the elements with the same key. KeyFn is as for SortedIndexBy.
+func InsertSortedBy(in, out, value, keyFn interface{}) error {
+	return insertSorted(in, out, value, keyFn)
+}
+
+// sorted is a sorted input along with the function its elements are sorted by.
+type sorted struct {
+	elements reflect.Value
+	key      reflect.Value
+}
+
+func newSorted(in, keyFn interface{}) (sorted, error) {
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return sorted{}, err
+	}
+	switch input.Kind() {
+	case reflect.Slice:
+	case reflect.Array:
+		elements := reflect.MakeSlice(reflect.SliceOf(input.Type().Elem()), input.Len(), input.Len())
+		reflect.Copy(elements, input)
+		input = elements
+	default:
+		return sorted{}, fmt.Errorf("not implemented for (%s)", input.Kind())
+	}
+
+	key, err := keyFnFor(input.Type().Elem(), keyFn, isOrdered)
+	if err != nil {
+		return sorted{}, err
+	}
+	return sorted{elements: input, key: key}, nil
+}
+
+func (s sorted) keyOf(element reflect.Value) reflect.Value {
+	if s.key.IsValid() {
+		return s.key.Call([]reflect.Value{element})[0]
+	}
+	return element
+}
+
+// valueKey validates value is of the element type of s and returns its key.
+func (s sorted) valueKey(value interface{}) (reflect.Value, error) {
+	element := reflect.ValueOf(value)
+	if elemType := s.elements.Type().Elem(); !element.IsValid() || element.Type() != elemType {
+		return reflect.Value{}, fmt.Errorf("value (%v) has to be of input's element type (%s)", value, elemType)
+	}
+	return s.keyOf(element), nil
+}
+
+// search returns the lowest index of an element whose key is not less than key,
+// or greater than key if after is set.
+func (s sorted) search(key reflect.Value, after bool) int {
+	return sort.Search(s.elements.Len(), func(i int) bool {
+		if after {
+			return less(key, s.keyOf(s.elements.Index(i)))
+		}
+		return !less(s.keyOf(s.elements.Index(i)), key)
+	})
+}
+
+func sortedIndex(in, value, keyFn interface{}, last bool) (int, error) {
+	s, err := newSorted(in, keyFn)
+	if err != nil {
+		return 0, err
+	}
+	key, err := s.valueKey(value)
+	if err != nil {
+		return 0, err
+	}
+	return s.search(key, last), nil
+}
+
+func binarySearch(in, value, keyFn interface{}) (int, bool, error) {
+	s, err := newSorted(in, keyFn)
+	if err != nil {
+		return 0, false, err
+	}
+	key, err := s.valueKey(value)
+	if err != nil {
+		return 0, false, err
+	}
+
+	index := s.search(key, false)
+	found := index < s.elements.Len() && !less(key, s.keyOf(s.elements.Index(index)))
+	return index, found, nil
+}
+
+func sortedUniq(in, out, keyFn interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	s, err := newSorted(in, keyFn)
+	if err != nil {
+		return err
+	}
+	if err := validateSortedOut(output, s); err != nil {
+		return err
+	}
+
+	result := reflect.MakeSlice(output.Elem().Type(), 0, 0)
+	var previous reflect.Value
+	for i := 0; i < s.elements.Len(); i++ {
+		element := s.elements.Index(i)
+		key := s.keyOf(element)
+		if i > 0 && !less(previous, key) {
+			continue
+		}
+		result = reflect.Append(result, element)
+		previous = key
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
+
+func insertSorted(in, out, value, keyFn interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	s, err := newSorted(in, keyFn)
+	if err != nil {
+		return err
+	}
+	key, err := s.valueKey(value)
+	if err != nil {
+		return err
+	}
+	if err := validateSortedOut(output, s); err != nil {
+		return err
+	}
+
+	index := s.search(key, true)
+	length := s.elements.Len()
+	result := reflect.MakeSlice(output.Elem().Type(), length+1, length+1)
+	reflect.Copy(result, s.elements.Slice(0, index))
+	result.Index(index).Set(reflect.ValueOf(value))
+	reflect.Copy(result.Slice(index+1, length+1), s.elements.Slice(index, length))
+	output.Elem().Set(result)
+
+	return nil
+}
+
+func validateSortedOut(output reflect.Value, s sorted) error {
+	elemType := s.elements.Type().Elem()
+	if output.Elem().Kind() != reflect.Slice || output.Elem().Type().Elem() != elemType {
+		return fmt.Errorf("output (%s) should be a slice of (%s)", output.Elem().Type(), elemType)
+	}
+	return nil
+}
@@ -0,0 +1,346 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type version struct {
+	Name  string
+	Major int
+}
+
+func TestSortedIndex(t *testing.T) {
+	in := []int{10, 20, 20, 20, 30}
+
+	t.Run("should return the lowest index to insert value at", func(t *testing.T) {
+		cases := []struct {
+			value    int
+			expected int
+		}{
+			{5, 0},
+			{10, 0},
+			{15, 1},
+			{20, 1},
+			{25, 4},
+			{30, 4},
+			{35, 5},
+		}
+
+		for _, c := range cases {
+			index, err := godash.SortedIndex(in, c.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, index, "SortedIndex(%v)", c.value)
+		}
+	})
+
+	t.Run("should support strings, floats, arrays and named types", func(t *testing.T) {
+		index, err := godash.SortedIndex([]string{"apple", "banana", "cherry"}, "blueberry")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, index)
+
+		index, err = godash.SortedIndex([3]float64{0.5, 1.5, 2.5}, 1.5)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, index)
+
+		type level uint8
+		index, err = godash.SortedIndex([]level{1, 3, 5}, level(4))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, index)
+	})
+
+	t.Run("should return zero for empty input", func(t *testing.T) {
+		index, err := godash.SortedIndex([]int{}, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, index)
+	})
+
+	t.Run("should accept an iterator", func(t *testing.T) {
+		numbers, _ := godash.RangeIterator(0, 100, 10)
+
+		index, err := godash.SortedIndex(numbers, 35)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, index)
+	})
+
+	t.Run("should validate input and value", func(t *testing.T) {
+		_, err := godash.SortedIndex(map[int]int{}, 1)
+		assert.EqualError(t, err, "not implemented for (map)")
+
+		_, err = godash.SortedIndex([]version{}, version{})
+		assert.EqualError(t, err, "input's element type (godash_test.version) is not supported")
+
+		_, err = godash.SortedIndex(in, "20")
+		assert.EqualError(t, err, "value (20) has to be of input's element type (int)")
+
+		_, err = godash.SortedIndex(in, int64(20))
+		assert.EqualError(t, err, "value (20) has to be of input's element type (int)")
+
+		_, err = godash.SortedIndex(in, nil)
+		assert.EqualError(t, err, "value (<nil>) has to be of input's element type (int)")
+	})
+}
+
+func TestSortedIndexBy(t *testing.T) {
+	versions := []version{{"v1", 1}, {"v2", 2}, {"v2.1", 2}, {"v3", 3}}
+
+	t.Run("should compare the keys returned by a key function", func(t *testing.T) {
+		index, err := godash.SortedIndexBy(versions, version{"v2.2", 2}, func(v version) int { return v.Major })
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, index)
+	})
+
+	t.Run("should resolve a path against each element", func(t *testing.T) {
+		index, err := godash.SortedIndexBy(versions, version{Major: 4}, "Major")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, index)
+	})
+
+	t.Run("should validate the key function", func(t *testing.T) {
+		_, err := godash.SortedIndexBy(versions, version{}, func(s string) int { return 0 })
+		assert.EqualError(t, err, "key function has to take only one argument of type (godash_test.version)")
+
+		_, err = godash.SortedIndexBy(versions, version{}, func(v version) (int, int) { return 0, 0 })
+		assert.EqualError(t, err, "key function should return only one return value")
+
+		_, err = godash.SortedIndexBy(versions, version{}, func(v version) []int { return nil })
+		assert.EqualError(t, err, "key function's return type ([]int) is not supported")
+	})
+}
+
+func TestSortedLastIndex(t *testing.T) {
+	in := []int{10, 20, 20, 20, 30}
+
+	t.Run("should return the highest index to insert value at", func(t *testing.T) {
+		cases := []struct {
+			value    int
+			expected int
+		}{
+			{5, 0},
+			{10, 1},
+			{20, 4},
+			{30, 5},
+			{35, 5},
+		}
+
+		for _, c := range cases {
+			index, err := godash.SortedLastIndex(in, c.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, index, "SortedLastIndex(%v)", c.value)
+		}
+	})
+
+	t.Run("should compare the keys returned by a key function", func(t *testing.T) {
+		versions := []version{{"v1", 1}, {"v2", 2}, {"v2.1", 2}, {"v3", 3}}
+
+		index, err := godash.SortedLastIndexBy(versions, version{"v2.2", 2}, "Major")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, index)
+	})
+}
+
+func TestBinarySearch(t *testing.T) {
+	in := []string{"a", "c", "c", "e"}
+
+	t.Run("should return the lowest index of value if it is found", func(t *testing.T) {
+		index, found, err := godash.BinarySearch(in, "c")
+
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, 1, index)
+	})
+
+	t.Run("should return the index to insert value at if it is not found", func(t *testing.T) {
+		cases := []struct {
+			value    string
+			expected int
+		}{
+			{"0", 0},
+			{"b", 1},
+			{"d", 3},
+			{"f", 4},
+		}
+
+		for _, c := range cases {
+			index, found, err := godash.BinarySearch(in, c.value)
+
+			assert.NoError(t, err)
+			assert.False(t, found, "BinarySearch(%v)", c.value)
+			assert.Equal(t, c.expected, index, "BinarySearch(%v)", c.value)
+		}
+	})
+
+	t.Run("should not find value in empty input", func(t *testing.T) {
+		index, found, err := godash.BinarySearch([]string{}, "a")
+
+		assert.NoError(t, err)
+		assert.False(t, found)
+		assert.Equal(t, 0, index)
+	})
+
+	t.Run("should find an element with the same key", func(t *testing.T) {
+		versions := []version{{"v1", 1}, {"v2", 2}, {"v3", 3}}
+
+		index, found, err := godash.BinarySearchBy(versions, version{Major: 3}, "Major")
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "v3", versions[index].Name)
+
+		_, found, err = godash.BinarySearchBy(versions, version{Major: 4}, "Major")
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("should validate value", func(t *testing.T) {
+		_, _, err := godash.BinarySearch(in, 'c')
+		assert.EqualError(t, err, "value (99) has to be of input's element type (string)")
+	})
+}
+
+func TestSortedUniq(t *testing.T) {
+	t.Run("should remove duplicates of sorted input", func(t *testing.T) {
+		in := []int{1, 1, 2, 3, 3, 3, 4}
+
+		var out []int
+		err := godash.SortedUniq(in, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, out)
+		assert.Equal(t, []int{1, 1, 2, 3, 3, 3, 4}, in, "input should not be modified")
+	})
+
+	t.Run("should set empty output for empty input", func(t *testing.T) {
+		var out []string
+		err := godash.SortedUniq([]string{}, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, out)
+	})
+
+	t.Run("should keep the first element of each key", func(t *testing.T) {
+		versions := []version{{"v1", 1}, {"v2", 2}, {"v2.1", 2}, {"v3", 3}, {"v3.1", 3}}
+
+		var out []version
+		err := godash.SortedUniqBy(versions, &out, func(v version) int { return v.Major })
+
+		assert.NoError(t, err)
+		assert.Equal(t, []version{{"v1", 1}, {"v2", 2}, {"v3", 3}}, out)
+	})
+
+	t.Run("should validate output", func(t *testing.T) {
+		var out []string
+		err := godash.SortedUniq([]int{1}, &out)
+		assert.EqualError(t, err, "output ([]string) should be a slice of (int)")
+
+		err = godash.SortedUniq([]int{1}, []int{})
+		assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+	})
+}
+
+func TestInsertSorted(t *testing.T) {
+	t.Run("should insert value keeping the output sorted", func(t *testing.T) {
+		in := []int{1, 3, 5}
+		cases := []struct {
+			value    int
+			expected []int
+		}{
+			{0, []int{0, 1, 3, 5}},
+			{4, []int{1, 3, 4, 5}},
+			{6, []int{1, 3, 5, 6}},
+		}
+
+		for _, c := range cases {
+			var out []int
+			err := godash.InsertSorted(in, &out, c.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, out)
+		}
+		assert.Equal(t, []int{1, 3, 5}, in, "input should not be modified")
+	})
+
+	t.Run("should insert into an array", func(t *testing.T) {
+		var out []int
+		err := godash.InsertSorted([3]int{1, 3, 5}, &out, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 3, 4, 5}, out)
+	})
+
+	t.Run("should insert into the input when passed as output", func(t *testing.T) {
+		in := make([]int, 0, 10)
+		for _, n := range []int{5, 2, 8, 2} {
+			assert.NoError(t, godash.InsertSorted(in, &in, n))
+		}
+
+		assert.Equal(t, []int{2, 2, 5, 8}, in)
+	})
+
+	t.Run("should insert value after the elements with the same key", func(t *testing.T) {
+		versions := []version{{"v1", 1}, {"v2", 2}, {"v3", 3}}
+
+		var out []version
+		err := godash.InsertSortedBy(versions, &out, version{"v2.1", 2}, "Major")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []version{{"v1", 1}, {"v2", 2}, {"v2.1", 2}, {"v3", 3}}, out)
+	})
+
+	t.Run("should validate value and output", func(t *testing.T) {
+		var out []int
+		err := godash.InsertSorted([]int{1}, &out, 1.5)
+		assert.EqualError(t, err, "value (1.5) has to be of input's element type (int)")
+
+		var notSlice int
+		err = godash.InsertSorted([]int{1}, &notSlice, 2)
+		assert.EqualError(t, err, "output (int) should be a slice of (int)")
+	})
+}
+
+func ExampleBinarySearch() {
+	releases := []string{"1.0.0", "1.1.0", "1.2.0", "2.0.0"}
+
+	index, found, _ := godash.BinarySearch(releases, "1.2.0")
+	fmt.Println(index, found)
+
+	index, found, _ = godash.BinarySearch(releases, "1.3.0")
+	fmt.Println(index, found)
+
+	// Output:
+	// 2 true
+	// 3 false
+}
+
+func ExampleInsertSorted() {
+	scores := []int{40, 70, 90}
+	_ = godash.InsertSorted(scores, &scores, 75)
+
+	fmt.Println(scores)
+
+	// Output: [40 70 75 90]
+}
+
+func ExampleSortedUniqBy() {
+	type event struct {
+		Day  int
+		Name string
+	}
+	events := []event{{1, "deploy"}, {1, "rollback"}, {2, "deploy"}, {4, "audit"}}
+
+	var firsts []event
+	_ = godash.SortedUniqBy(events, &firsts, "Day")
+
+	fmt.Println(firsts)
+
+	// Output: [{1 deploy} {2 deploy} {4 audit}]
+}
@@ -0,0 +1,218 @@
+package godash
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Median computes the median of the elements of in and sets it in out.
+// For an even number of elements, it is the mean of the two middle elements.
+// An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers
+//	2. Output should be a reference to a float
+//
+// Validation errors are returned to the caller.
+func Median(in, out interface{}) error {
+	return percentile("Median", in, out, nil, 50)
+}
+
+// MedianBy is like Median except that keyFn is invoked for each element to get the number to be used.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func MedianBy(in, out, keyFn interface{}) error {
+	return percentile("MedianBy", in, out, keyFn, 50)
+}
+
+// Percentile computes the p-th percentile of the elements of in and sets it in out.
+// P ranges from 0 to 100, and values between two elements are linearly interpolated,
+// so that Percentile with 0, 50 and 100 is the same as Min, Median and Max.
+// An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers
+//	2. Output should be a reference to a float
+//	3. P should be between 0 and 100
+//
+// Validation errors are returned to the caller.
+func Percentile(in, out interface{}, p float64) error {
+	return percentile("Percentile", in, out, nil, p)
+}
+
+// PercentileBy is like Percentile except that keyFn is invoked for each element to get the number to be used.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func PercentileBy(in, out, keyFn interface{}, p float64) error {
+	return percentile("PercentileBy", in, out, keyFn, p)
+}
+
+// Variance computes the population variance of the elements of in and sets it in out.
+// It is computed in a single pass with Welford's algorithm, so channels are not buffered.
+// An *AggregateError with ErrEmpty is returned if the input has no elements.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers
+//	2. Output should be a reference to a float
+//
+// Validation errors are returned to the caller.
+func Variance(in, out interface{}) error {
+	return variance("Variance", in, out, nil, false)
+}
+
+// VarianceBy is like Variance except that keyFn is invoked for each element to get the number to be used.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func VarianceBy(in, out, keyFn interface{}) error {
+	return variance("VarianceBy", in, out, keyFn, false)
+}
+
+// StdDev computes the population standard deviation of the elements of in, ie. the square root of Variance,
+// and sets it in out. It is validated like Variance.
+func StdDev(in, out interface{}) error {
+	return variance("StdDev", in, out, nil, true)
+}
+
+// StdDevBy is like StdDev except that keyFn is invoked for each element to get the number to be used.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func StdDevBy(in, out, keyFn interface{}) error {
+	return variance("StdDevBy", in, out, keyFn, true)
+}
+
+// Histogram counts the elements of in that fall in each bucket and sets the counts in out.
+//
+// Buckets are the boundaries between buckets in increasing order, so n boundaries make n+1 buckets.
+// The count at index i is of elements that are at least buckets[i-1] and less than buckets[i].
+// The first count is of elements less than buckets[0] and the last is of elements that are at least the last boundary.
+// Elements are counted in a single pass, so channels are not buffered.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array or a channel of numbers
+//	2. Output should be a reference to a slice of integers
+//	3. Buckets should be in increasing order
+//
+// Validation errors are returned to the caller.
+func Histogram(in, out interface{}, buckets []float64) error {
+	return histogram(in, out, nil, buckets)
+}
+
+// HistogramBy is like Histogram except that keyFn is invoked for each element to get the number to be counted.
+// KeyFn is of the form func(T) N, or a path like Get accepts which is resolved against each element.
+func HistogramBy(in, out, keyFn interface{}, buckets []float64) error {
+	return histogram(in, out, keyFn, buckets)
+}
+
+func percentile(name string, in, out, keyFn interface{}, p float64) error {
+	output, err := floatOut(out)
+	if err != nil {
+		return err
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return fmt.Errorf("percentile (%v) has to be between 0 and 100", p)
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	_, keys, err := aggregateKeys(input, keyFn, isNumber)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return &AggregateError{Func: name, Err: ErrEmpty}
+	}
+
+	numbers := make([]float64, len(keys))
+	for i, key := range keys {
+		numbers[i] = toFloat(key)
+	}
+	sort.Float64s(numbers)
+
+	rank := p / 100 * float64(len(numbers)-1)
+	lower := int(math.Floor(rank))
+	result := numbers[lower]
+	if fraction := rank - float64(lower); fraction > 0 {
+		result += fraction * (numbers[lower+1] - numbers[lower])
+	}
+	output.SetFloat(result)
+
+	return nil
+}
+
+func variance(name string, in, out, keyFn interface{}, stdDev bool) error {
+	output, err := floatOut(out)
+	if err != nil {
+		return err
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	key, err := aggregateKeyFn(input, keyFn, isNumber)
+	if err != nil {
+		return err
+	}
+
+	count, mean, squaredDistances := 0, 0.0, 0.0
+	eachAggregated(input, key, func(_, key reflect.Value) {
+		number := toFloat(key)
+		count++
+		delta := number - mean
+		mean += delta / float64(count)
+		squaredDistances += delta * (number - mean)
+	})
+	if count == 0 {
+		return &AggregateError{Func: name, Err: ErrEmpty}
+	}
+
+	result := squaredDistances / float64(count)
+	if stdDev {
+		result = math.Sqrt(result)
+	}
+	output.SetFloat(result)
+
+	return nil
+}
+
+func histogram(in, out, keyFn interface{}, buckets []float64) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	outputType := output.Elem().Type()
+	if outputType.Kind() != reflect.Slice || !isSigned(outputType.Elem().Kind()) && !isUnsigned(outputType.Elem().Kind()) {
+		return fmt.Errorf("output (%s) has to be a slice of integers", outputType)
+	}
+	for i := 1; i < len(buckets); i++ {
+		if !(buckets[i-1] < buckets[i]) {
+			return fmt.Errorf("buckets have to be in increasing order")
+		}
+	}
+	input, err := iteratorElements(reflect.ValueOf(in))
+	if err != nil {
+		return err
+	}
+	key, err := aggregateKeyFn(input, keyFn, isNumber)
+	if err != nil {
+		return err
+	}
+
+	counts := make([]uint64, len(buckets)+1)
+	eachAggregated(input, key, func(_, key reflect.Value) {
+		number := toFloat(key)
+		counts[sort.Search(len(buckets), func(i int) bool {
+			return number < buckets[i]
+		})]++
+	})
+
+	result := reflect.MakeSlice(outputType, len(counts), len(counts))
+	for i, count := range counts {
+		result.Index(i).Set(reflect.ValueOf(count).Convert(outputType.Elem()))
+	}
+	output.Elem().Set(result)
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package godash_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestMedian(t *testing.T) {
+	t.Run("should compute the median", func(t *testing.T) {
+		var out float64
+		{
+			err := godash.Median([]int{3, 1, 2}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 2.0, out)
+		}
+		{
+			err := godash.Median([]float64{4, 1, 3, 2}, &out)
+			assert.NoError(t, err)
+			assert.Equal(t, 2.5, out)
+		}
+		{
+			err := godash.MedianBy(employees, &out, "Salary")
+			assert.NoError(t, err)
+			assert.Equal(t, 200.0, out)
+		}
+	})
+
+	t.Run("should return error for empty input", func(t *testing.T) {
+		var out float64
+
+		err := godash.Median([]int{}, &out)
+
+		assert.EqualError(t, err, "cannot compute (Median): input is empty")
+		assert.True(t, errors.Is(err, godash.ErrEmpty))
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := []int{15, 20, 35, 40, 50}
+
+	t.Run("should interpolate between elements", func(t *testing.T) {
+		cases := map[float64]float64{0: 15, 25: 20, 40: 29, 50: 35, 90: 46, 100: 50}
+		for p, expected := range cases {
+			var out float64
+
+			err := godash.Percentile(latencies, &out, p)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, expected, out, 1e-9, "percentile %v", p)
+		}
+	})
+
+	t.Run("should support channels", func(t *testing.T) {
+		in := make(chan int, len(latencies))
+		for _, latency := range latencies {
+			in <- latency
+		}
+		close(in)
+		var out float32
+
+		err := godash.Percentile(in, &out, 50)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float32(35), out)
+	})
+
+	t.Run("should validate percentile and output", func(t *testing.T) {
+		{
+			var out float64
+			err := godash.Percentile(latencies, &out, 101)
+			assert.EqualError(t, err, "percentile (101) has to be between 0 and 100")
+		}
+		{
+			var out int
+			err := godash.Percentile(latencies, &out, 50)
+			assert.EqualError(t, err, "output (int) has to be a float")
+		}
+		{
+			var out float64
+			err := godash.PercentileBy(employees, &out, "Name", 50)
+			assert.EqualError(t, err, "key function's return type (string) is not supported")
+		}
+	})
+}
+
+func TestVariance(t *testing.T) {
+	numbers := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	t.Run("should compute the population variance and standard deviation", func(t *testing.T) {
+		var variance, stdDev float64
+
+		assert.NoError(t, godash.Variance(numbers, &variance))
+		assert.NoError(t, godash.StdDev(numbers, &stdDev))
+
+		assert.Equal(t, 4.0, variance)
+		assert.Equal(t, 2.0, stdDev)
+	})
+
+	t.Run("should be numerically stable for large values", func(t *testing.T) {
+		var out float64
+
+		err := godash.Variance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}, &out)
+
+		assert.NoError(t, err)
+		assert.InDelta(t, 22.5, out, 1e-6)
+	})
+
+	t.Run("should support key functions and channels", func(t *testing.T) {
+		in := make(chan employee, len(employees))
+		for _, e := range employees {
+			in <- e
+		}
+		close(in)
+		var out float64
+
+		err := godash.StdDevBy(in, &out, func(e employee) int {
+			return e.Age
+		})
+
+		assert.NoError(t, err)
+		assert.InDelta(t, math.Sqrt(50.0/3), out, 1e-9)
+	})
+
+	t.Run("should return error for empty input", func(t *testing.T) {
+		var out float64
+
+		err := godash.VarianceBy([]employee{}, &out, "Age")
+
+		assert.EqualError(t, err, "cannot compute (VarianceBy): input is empty")
+	})
+
+	t.Run("should validate input", func(t *testing.T) {
+		var out float64
+		{
+			err := godash.Variance(make(chan<- int), &out)
+			assert.EqualError(t, err, "input channel (chan<- int) has to allow receiving")
+		}
+		{
+			err := godash.StdDev("numbers", &out)
+			assert.EqualError(t, err, "not implemented for (string)")
+		}
+	})
+}
+
+func TestHistogram(t *testing.T) {
+	t.Run("should count elements in each bucket", func(t *testing.T) {
+		var out []int
+
+		err := godash.Histogram([]float64{5, 10, 15, 99, 100, 250, -1}, &out, []float64{10, 100, 200})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 3, 1, 1}, out)
+	})
+
+	t.Run("should count keys of elements", func(t *testing.T) {
+		var out []uint
+
+		err := godash.HistogramBy(employees, &out, "Age", []float64{30})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{1, 2}, out)
+	})
+
+	t.Run("should put everything in one bucket without boundaries", func(t *testing.T) {
+		var out []int
+
+		err := godash.Histogram([]int{1, 2}, &out, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2}, out)
+	})
+
+	t.Run("should validate buckets and output", func(t *testing.T) {
+		{
+			var out []int
+			err := godash.Histogram([]int{1}, &out, []float64{10, 10})
+			assert.EqualError(t, err, "buckets have to be in increasing order")
+		}
+		{
+			var out []float64
+			err := godash.Histogram([]int{1}, &out, []float64{10})
+			assert.EqualError(t, err, "output ([]float64) has to be a slice of integers")
+		}
+	})
+}
+
+func ExamplePercentile() {
+	latencies := []int{15, 20, 35, 40, 50}
+	var p90 float64
+
+	_ = godash.Percentile(latencies, &p90, 90)
+
+	fmt.Println(p90)
+
+	// Output: 46
+}
+
+func ExampleStdDev() {
+	var stdDev float64
+
+	_ = godash.StdDev([]int{2, 4, 4, 4, 5, 5, 7, 9}, &stdDev)
+
+	fmt.Println(stdDev)
+
+	// Output: 2
+}
+
+func ExampleHistogram() {
+	latencies := []int{5, 12, 40, 180, 350}
+	var counts []int
+
+	_ = godash.Histogram(latencies, &counts, []float64{10, 100, 200})
+
+	fmt.Println(counts)
+
+	// Output: [1 2 1 1]
+}
@@ -0,0 +1,60 @@
+package str
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Pad pads both sides of s with chars so that it is length runes long.
+// Chars are repeated and cut as needed and spaces are used if chars is empty.
+// The left side gets the smaller half when the padding cannot be split evenly.
+// S is returned as is if it is already length runes long or longer.
+func Pad(s string, length int, chars string) string {
+	total := length - utf8.RuneCountInString(s)
+	if total <= 0 {
+		return s
+	}
+	left := total / 2
+	return padding(left, chars) + s + padding(total-left, chars)
+}
+
+// PadStart pads the start of s with chars so that it is length runes long. It is otherwise like Pad.
+func PadStart(s string, length int, chars string) string {
+	return padding(length-utf8.RuneCountInString(s), chars) + s
+}
+
+// PadEnd pads the end of s with chars so that it is length runes long. It is otherwise like Pad.
+func PadEnd(s string, length int, chars string) string {
+	return s + padding(length-utf8.RuneCountInString(s), chars)
+}
+
+// Truncate shortens s to length runes if it is longer, ending it with omission.
+// The result including the omission is at most length runes long, so the omission itself is cut
+// if it is longer than length.
+func Truncate(s string, length int, omission string) string {
+	if utf8.RuneCountInString(s) <= length {
+		return s
+	}
+	if length < 0 {
+		length = 0
+	}
+
+	omissionRunes := []rune(omission)
+	if len(omissionRunes) >= length {
+		return string(omissionRunes[:length])
+	}
+	return string([]rune(s)[:length-len(omissionRunes)]) + omission
+}
+
+// padding returns chars repeated and cut to be length runes long.
+func padding(length int, chars string) string {
+	if length <= 0 {
+		return ""
+	}
+	if chars == "" {
+		chars = " "
+	}
+
+	runes := []rune(strings.Repeat(chars, length/utf8.RuneCountInString(chars)+1))
+	return string(runes[:length])
+}
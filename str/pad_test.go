@@ -0,0 +1,64 @@
+package str_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash/str"
+)
+
+func TestPad(t *testing.T) {
+	t.Run("should pad both sides", func(t *testing.T) {
+		assert.Equal(t, "  abc   ", str.Pad("abc", 8, ""))
+		assert.Equal(t, "_-abc_-_", str.Pad("abc", 8, "_-"))
+		assert.Equal(t, "abc", str.Pad("abc", 2, "_"))
+	})
+
+	t.Run("should pad start and end", func(t *testing.T) {
+		assert.Equal(t, "_-_abc", str.PadStart("abc", 6, "_-"))
+		assert.Equal(t, "abc_-_", str.PadEnd("abc", 6, "_-"))
+		assert.Equal(t, "abc", str.PadStart("abc", -1, "_"))
+	})
+
+	t.Run("should count runes", func(t *testing.T) {
+		assert.Equal(t, "ééé", str.PadStart("é", 3, "é"))
+		assert.Equal(t, "·héllo·", str.Pad("héllo", 7, "·"))
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("should truncate long strings with omission", func(t *testing.T) {
+		assert.Equal(t, "hi-did...", str.Truncate("hi-diddly-ho there", 9, "..."))
+		assert.Equal(t, "hi-diddly…", str.Truncate("hi-diddly-ho there", 10, "…"))
+		assert.Equal(t, "hi-d", str.Truncate("hi-diddly-ho there", 4, ""))
+	})
+
+	t.Run("should not truncate short strings", func(t *testing.T) {
+		assert.Equal(t, "short", str.Truncate("short", 5, "..."))
+	})
+
+	t.Run("should cut omission longer than length", func(t *testing.T) {
+		assert.Equal(t, "..", str.Truncate("hello", 2, "..."))
+		assert.Equal(t, "", str.Truncate("hello", -1, "..."))
+	})
+
+	t.Run("should count runes", func(t *testing.T) {
+		assert.Equal(t, "crè…", str.Truncate("crème brûlée", 4, "…"))
+	})
+}
+
+func ExamplePad() {
+	fmt.Printf("[%s]\n", str.Pad("abc", 7, "*"))
+	fmt.Printf("[%s]\n", str.PadStart("42", 5, "0"))
+
+	// Output:
+	// [**abc**]
+	// [00042]
+}
+
+func ExampleTruncate() {
+	fmt.Println(str.Truncate("The quick brown fox", 12, "..."))
+
+	// Output: The quick...
+}
@@ -0,0 +1,67 @@
+package str
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thecasualcoder/godash"
+)
+
+// Template interpolates placeholders like "${user.name}" in tmpl with values from data.
+//
+// Placeholders hold paths like the ones accepted by godash.Get, which are resolved against data
+// through struct fields (by name or json tag), map keys, slice indexes and pointers.
+// Values are formatted with fmt.Sprint. A literal "$" can be written as "$$".
+//
+// Validations:
+//
+//	1. Placeholders should be closed with a "}"
+//	2. Paths in placeholders should exist in data
+//
+// Path mismatches are returned as *godash.PathError and validation errors are returned to the caller.
+func Template(tmpl string, data interface{}) (string, error) {
+	var builder strings.Builder
+	rest := tmpl
+
+	for {
+		start := strings.IndexByte(rest, '$')
+		if start < 0 || start == len(rest)-1 {
+			builder.WriteString(rest)
+			return builder.String(), nil
+		}
+		builder.WriteString(rest[:start])
+
+		switch rest[start+1] {
+		case '$':
+			builder.WriteByte('$')
+			rest = rest[start+2:]
+			continue
+		case '{':
+		default:
+			builder.WriteByte('$')
+			rest = rest[start+1:]
+			continue
+		}
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("placeholder at (%d) is not closed", len(tmpl)-len(rest)+start)
+		}
+		path := strings.TrimSpace(rest[start+2 : start+end])
+
+		found, err := godash.Has(data, path)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return "", fmt.Errorf("no value found at path (%s)", path)
+		}
+		var value interface{}
+		if err := godash.Get(data, path, &value); err != nil {
+			return "", err
+		}
+		builder.WriteString(fmt.Sprint(value))
+
+		rest = rest[start+end+1:]
+	}
+}
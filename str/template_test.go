@@ -0,0 +1,66 @@
+package str_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+	"github.com/thecasualcoder/godash/str"
+)
+
+func TestTemplate(t *testing.T) {
+	type user struct {
+		Name  string `json:"name"`
+		Roles []string
+	}
+	data := map[string]interface{}{
+		"user":  user{Name: "John", Roles: []string{"admin", "dev"}},
+		"count": 3,
+	}
+
+	t.Run("should interpolate values at paths", func(t *testing.T) {
+		result, err := str.Template("${user.name} is ${ user.Roles[0] } and has ${count} tasks", data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "John is admin and has 3 tasks", result)
+	})
+
+	t.Run("should keep dollars that do not start placeholders", func(t *testing.T) {
+		result, err := str.Template("costs $5, $${count} and ${count}$", data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "costs $5, ${count} and 3$", result)
+	})
+
+	t.Run("should return error for missing paths", func(t *testing.T) {
+		_, err := str.Template("${user.email}", data)
+
+		assert.EqualError(t, err, "path (user.email) at (email): field not found in (str_test.user)")
+		var pathErr *godash.PathError
+		assert.True(t, errors.As(err, &pathErr))
+	})
+
+	t.Run("should return error for missing map keys", func(t *testing.T) {
+		_, err := str.Template("${missing}", data)
+
+		assert.EqualError(t, err, "no value found at path (missing)")
+	})
+
+	t.Run("should return error for unclosed placeholders", func(t *testing.T) {
+		_, err := str.Template("hello ${user.name", data)
+
+		assert.EqualError(t, err, "placeholder at (6) is not closed")
+	})
+}
+
+func ExampleTemplate() {
+	data := map[string]interface{}{"name": "John", "count": 3}
+
+	result, _ := str.Template("Hello ${name}, you have ${count} new messages", data)
+
+	fmt.Println(result)
+
+	// Output: Hello John, you have 3 new messages
+}
@@ -0,0 +1,118 @@
+// Package str provides string utilities inspired by Lodash, like changing the case of words,
+// padding, truncating and interpolating strings.
+//
+// Strings are handled as runes, so that lengths and words are Unicode-aware.
+package str
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words splits s into its words.
+//
+// Words are separated by anything that is not a letter or a digit, and by case changes
+// like in "fooBar". A run of upper case letters is kept as one word, except for its last letter
+// if it starts a capitalized word, so "HTTPServer" is split into "HTTP" and "Server".
+// Digits are kept with the letters before them, and combining marks with the letters they follow,
+// so decomposed text like "cre\u0300me" is kept as one word.
+func Words(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+
+	for i, r := range runes {
+		if unicode.IsMark(r) && start >= 0 {
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && isWordBoundary(runes, i) {
+			words = append(words, string(runes[start:i]))
+			start = -1
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+// isWordBoundary reports whether a new word starts at runes[i] within a run of letters and digits.
+// Combining marks are skipped when looking at the letters around runes[i].
+func isWordBoundary(runes []rune, i int) bool {
+	current := runes[i]
+	if !unicode.IsUpper(current) {
+		return false
+	}
+	previous := i - 1
+	for previous > 0 && unicode.IsMark(runes[previous]) {
+		previous--
+	}
+	if unicode.IsLower(runes[previous]) || unicode.IsDigit(runes[previous]) {
+		return true
+	}
+	next := i + 1
+	for next < len(runes) && unicode.IsMark(runes[next]) {
+		next++
+	}
+	// the last upper case letter of an acronym starts a capitalized word, as in "HTTPServer"
+	return unicode.IsUpper(runes[previous]) && next < len(runes) && unicode.IsLower(runes[next])
+}
+
+// CamelCase converts s to camel case, like "fooBar" for "Foo bar" or "httpServer" for "HTTPServer".
+func CamelCase(s string) string {
+	var builder strings.Builder
+	for i, word := range Words(s) {
+		if i == 0 {
+			builder.WriteString(strings.ToLower(word))
+			continue
+		}
+		builder.WriteString(capitalize(word))
+	}
+	return builder.String()
+}
+
+// SnakeCase converts s to snake case, like "foo_bar" for "Foo bar" or "http_server" for "HTTPServer".
+func SnakeCase(s string) string {
+	return joinLower(Words(s), "_")
+}
+
+// KebabCase converts s to kebab case, like "foo-bar" for "Foo bar" or "http-server" for "HTTPServer".
+func KebabCase(s string) string {
+	return joinLower(Words(s), "-")
+}
+
+// StartCase converts s to start case, like "Foo Bar" for "fooBar" or "HTTP Server" for "HTTPServer".
+// Only the first letter of each word is changed, so acronyms stay in upper case.
+func StartCase(s string) string {
+	words := Words(s)
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
+
+func joinLower(words []string, separator string) string {
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	return strings.Join(words, separator)
+}
+
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,77 @@
+package str_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash/str"
+)
+
+func TestWords(t *testing.T) {
+	t.Run("should split words on separators and case changes", func(t *testing.T) {
+		cases := map[string][]string{
+			"foo bar":                        {"foo", "bar"},
+			"fooBar":                         {"foo", "Bar"},
+			"__FOO_BAR__":                    {"FOO", "BAR"},
+			"HTTPServer":                     {"HTTP", "Server"},
+			"parseHTTPSURL":                  {"parse", "HTTPSURL"},
+			"getID":                          {"get", "ID"},
+			"version2Beta":                   {"version2", "Beta"},
+			"crème-brûlée":                   {"crème", "brûlée"},
+			"ÉtéHiver":                       {"Été", "Hiver"},
+			"cre\u0300me-bru\u0302le\u0301e": {"cre\u0300me", "bru\u0302le\u0301e"},
+			"E\u0301te\u0301Hiver":           {"E\u0301te\u0301", "Hiver"},
+			"HTTPE\u0301te\u0301":            {"HTTP", "E\u0301te\u0301"},
+			"\u0301foo":                      {"foo"},
+			"  leading space":                {"leading", "space"},
+		}
+
+		for in, expected := range cases {
+			assert.Equal(t, expected, str.Words(in), in)
+		}
+	})
+
+	t.Run("should return no words for empty strings", func(t *testing.T) {
+		assert.Empty(t, str.Words(""))
+		assert.Empty(t, str.Words("-_ "))
+	})
+}
+
+func TestCases(t *testing.T) {
+	cases := []struct {
+		in, camel, snake, kebab, start string
+	}{
+		{"Foo bar", "fooBar", "foo_bar", "foo-bar", "Foo Bar"},
+		{"HTTPServer", "httpServer", "http_server", "http-server", "HTTP Server"},
+		{"__FOO_BAR__", "fooBar", "foo_bar", "foo-bar", "FOO BAR"},
+		{"user-id", "userId", "user_id", "user-id", "User Id"},
+		{"", "", "", "", ""},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.camel, str.CamelCase(c.in), "CamelCase(%q)", c.in)
+		assert.Equal(t, c.snake, str.SnakeCase(c.in), "SnakeCase(%q)", c.in)
+		assert.Equal(t, c.kebab, str.KebabCase(c.in), "KebabCase(%q)", c.in)
+		assert.Equal(t, c.start, str.StartCase(c.in), "StartCase(%q)", c.in)
+	}
+}
+
+func ExampleWords() {
+	fmt.Printf("%q\n", str.Words("HTTPServer_config"))
+
+	// Output: ["HTTP" "Server" "config"]
+}
+
+func ExampleCamelCase() {
+	fmt.Println(str.CamelCase("user_id"))
+	fmt.Println(str.SnakeCase("userID"))
+	fmt.Println(str.KebabCase("UserID"))
+	fmt.Println(str.StartCase("user-id"))
+
+	// Output:
+	// userId
+	// user_id
+	// user-id
+	// User Id
+}
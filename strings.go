@@ -0,0 +1,34 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Bytes is a string that Map, Filter, All, Any and Find iterate over byte by byte.
+// Other strings are iterated over rune by rune, so that multi-byte UTF-8 characters are kept whole.
+//
+//	godash.Filter(godash.Bytes("héllo"), &ascii, func(b byte) bool { return b < utf8.RuneSelf })
+type Bytes string
+
+var (
+	runeType  = reflect.TypeOf(rune(0))
+	byteType  = reflect.TypeOf(byte(0))
+	bytesType = reflect.TypeOf(Bytes(""))
+)
+
+// stringElements returns a string input as a slice of its runes, or of its bytes if it is of type Bytes.
+// Other inputs are returned as is.
+func stringElements(input reflect.Value) reflect.Value {
+	if input.Kind() != reflect.String {
+		return input
+	}
+	if input.Type() == bytesType {
+		return reflect.ValueOf([]byte(input.String()))
+	}
+	return reflect.ValueOf([]rune(input.String()))
+}
+
+// isRuneOrByte reports whether a slice of t can be converted to a string.
+func isRuneOrByte(t reflect.Type) bool {
+	return t == runeType || t == byteType
+}
@@ -0,0 +1,129 @@
+package godash_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestStringInput(t *testing.T) {
+	t.Run("should filter runes into a string or a slice of runes", func(t *testing.T) {
+		isNotL := func(r rune) bool { return r != 'l' }
+		{
+			var out string
+			err := godash.Filter("héllo wörld", &out, isNotL)
+			assert.NoError(t, err)
+			assert.Equal(t, "héo wörd", out)
+		}
+		{
+			var out []rune
+			err := godash.Filter("héllo", &out, isNotL)
+			assert.NoError(t, err)
+			assert.Equal(t, []rune("héo"), out)
+		}
+	})
+
+	t.Run("should iterate over bytes of Bytes", func(t *testing.T) {
+		var out string
+
+		err := godash.Filter(godash.Bytes("héllo"), &out, func(b byte) bool {
+			return b < utf8.RuneSelf
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hllo", out)
+	})
+
+	t.Run("should map runes into a string or a slice", func(t *testing.T) {
+		{
+			var out string
+			err := godash.Map("héllo", &out, unicode.ToUpper)
+			assert.NoError(t, err)
+			assert.Equal(t, "HÉLLO", out)
+		}
+		{
+			var out []bool
+			err := godash.Map("aB", &out, unicode.IsUpper)
+			assert.NoError(t, err)
+			assert.Equal(t, []bool{false, true}, out)
+		}
+		{
+			var out []byte
+			err := godash.Map(godash.Bytes("é"), &out, func(b byte) byte { return b })
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("é"), out)
+		}
+	})
+
+	t.Run("should check runes with All and Any", func(t *testing.T) {
+		all, err := godash.All("héllo", unicode.IsLetter)
+		assert.NoError(t, err)
+		assert.True(t, all)
+
+		any, err := godash.Any("héllo", unicode.IsUpper)
+		assert.NoError(t, err)
+		assert.False(t, any)
+	})
+
+	t.Run("should find runes", func(t *testing.T) {
+		var out rune
+
+		err := godash.Find("héllo", &out, func(r rune) bool {
+			return r > unicode.MaxASCII
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 'é', out)
+	})
+
+	t.Run("should validate output and functions for string input", func(t *testing.T) {
+		{
+			var out []string
+			err := godash.Filter("abc", &out, func(rune) bool { return true })
+			assert.EqualError(t, err, "output ([]string) should be a string or ([]int32) for input of type string")
+		}
+		{
+			var out string
+			err := godash.Map("abc", &out, func(r rune) string { return "" })
+			assert.EqualError(t, err, "mapper function's return type has to be (rune) or (byte) for output of type string but is (string)")
+		}
+		{
+			var out string
+			err := godash.Filter("abc", &out, func(string) bool { return true })
+			assert.EqualError(t, err, "predicate function's first argument has to be the type (int32) instead of (string)")
+		}
+		{
+			_, err := godash.All(godash.Bytes("abc"), unicode.IsLetter)
+			assert.EqualError(t, err, "predicate function's argument (int32) has to be (uint8)")
+		}
+	})
+}
+
+func ExampleBytes() {
+	var ascii string
+
+	_ = godash.Filter(godash.Bytes("héllo"), &ascii, func(b byte) bool {
+		return b < utf8.RuneSelf
+	})
+
+	fmt.Println(ascii)
+
+	// Output: hllo
+}
+
+func ExampleFilter_string() {
+	var consonants string
+
+	_ = godash.Filter("crème brûlée", &consonants, func(r rune) bool {
+		return !strings.ContainsRune("aeiouèéû ", r)
+	})
+
+	fmt.Println(consonants)
+
+	// Output: crmbrl
+}
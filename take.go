@@ -0,0 +1,192 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Take sets in out the first n elements of in, or all of them if in has fewer elements.
+// A negative n is treated as zero.
+//
+// Input can be a slice, an array, a string, a channel or an Iterator. Strings are taken rune by rune.
+// Only the elements taken are received from a channel or an Iterator, so the rest are left to be consumed by others.
+//
+// Validations:
+//
+//	1. Input should be a slice, an array, a string, a channel or an Iterator
+//	2. Output should be a reference to a string for string input, or a slice of input's element type otherwise
+//
+// Validation errors are returned to the caller.
+func Take(in, out interface{}, n int) error {
+	return slicePart(in, out, n, nil, false, true, false)
+}
+
+// TakeRight sets in out the last n elements of in. Channels are received from until they are closed.
+// It is validated like Take.
+func TakeRight(in, out interface{}, n int) error {
+	return slicePart(in, out, n, nil, false, true, true)
+}
+
+// TakeWhile sets in out the elements from the start of in until predicateFn fails for one of them.
+//
+// Elements are received from a channel or an Iterator only until predicateFn fails,
+// and the element it fails for is received and discarded.
+// Instead of a function, predicateFn can also be a Matcher created by Matches or MatchesProperty.
+//
+// Validations in addition to that of Take:
+//
+//	1. Predicate function should take one argument of input's element type (rune for strings) and return a bool
+func TakeWhile(in, out, predicateFn interface{}) error {
+	return slicePart(in, out, 0, predicateFn, true, true, false)
+}
+
+// TakeRightWhile sets in out the elements from the end of in until predicateFn fails for one of them.
+// Channels are received from until they are closed. It is validated like TakeWhile.
+func TakeRightWhile(in, out, predicateFn interface{}) error {
+	return slicePart(in, out, 0, predicateFn, true, true, true)
+}
+
+// Drop sets in out the elements of in except the first n. Channels are received from until they are closed.
+// It is validated like Take.
+func Drop(in, out interface{}, n int) error {
+	return slicePart(in, out, n, nil, false, false, false)
+}
+
+// DropRight sets in out the elements of in except the last n. Channels are received from until they are closed.
+// It is validated like Take.
+func DropRight(in, out interface{}, n int) error {
+	return slicePart(in, out, n, nil, false, false, true)
+}
+
+// DropWhile sets in out the elements of in except those from the start until predicateFn fails for one of them.
+// Channels are received from until they are closed. It is validated like TakeWhile.
+func DropWhile(in, out, predicateFn interface{}) error {
+	return slicePart(in, out, 0, predicateFn, true, false, false)
+}
+
+// DropRightWhile sets in out the elements of in except those from the end until predicateFn fails for one of them.
+// Channels are received from until they are closed. It is validated like TakeWhile.
+func DropRightWhile(in, out, predicateFn interface{}) error {
+	return slicePart(in, out, 0, predicateFn, true, false, true)
+}
+
+// slicePart sets in out the leading (or trailing if fromRight) elements of in, or the rest of them if take is false.
+// The leading elements are the first n, or those until predicateFn fails if byPredicate is set.
+func slicePart(in, out interface{}, n int, predicateFn interface{}, byPredicate, take, fromRight bool) error {
+	input := reflect.ValueOf(in)
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+
+	// recv receives the elements of channels and Iterators
+	var recv func() (reflect.Value, bool, error)
+	var elemType reflect.Type
+	iterator, isIterator := iteratorOf(input)
+	isString := input.Kind() == reflect.String && !isIterator
+	switch kind := input.Kind(); {
+	case isIterator:
+		elemType, recv = iterator.elemType, iterator.recv
+	case isString:
+		elemType = reflect.TypeOf(rune(0))
+		if output.Elem().Kind() != reflect.String {
+			return fmt.Errorf("output (%s) should be a string for input of type string", output.Elem().Type())
+		}
+	case kind == reflect.Slice, kind == reflect.Array, kind == reflect.Chan:
+		elemType = input.Type().Elem()
+		if kind == reflect.Chan {
+			if input.Type().ChanDir()&reflect.RecvDir == 0 {
+				return fmt.Errorf("input channel (%s) has to allow receiving", input.Type())
+			}
+			recv = func() (reflect.Value, bool, error) {
+				element, ok := input.Recv()
+				return element, ok, nil
+			}
+		}
+	default:
+		return fmt.Errorf("not implemented for (%s)", kind)
+	}
+	if !isString && (output.Elem().Kind() != reflect.Slice || output.Elem().Type().Elem() != elemType) {
+		return fmt.Errorf("output (%s) should be a slice of (%s)", output.Elem().Type(), elemType)
+	}
+
+	var predicate reflect.Value
+	if byPredicate {
+		var err error
+		if predicate, err = validatePredicate(elemType, predicateFn); err != nil {
+			return err
+		}
+	}
+	leading := func(element reflect.Value, count int) bool {
+		if byPredicate {
+			return predicate.Call([]reflect.Value{element})[0].Bool()
+		}
+		return count < n
+	}
+
+	var elements reflect.Value
+	switch {
+	case recv != nil:
+		elements = reflect.MakeSlice(reflect.SliceOf(elemType), 0, 0)
+		for count := 0; !take || fromRight || byPredicate || count < n; count++ {
+			element, ok, err := recv()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			if take && !fromRight && !leading(element, count) {
+				break
+			}
+			elements = reflect.Append(elements, element)
+		}
+	case isString:
+		elements = reflect.ValueOf([]rune(input.String()))
+	case input.Kind() == reflect.Array:
+		elements = reflect.MakeSlice(reflect.SliceOf(elemType), input.Len(), input.Len())
+		reflect.Copy(elements, input)
+	default:
+		elements = input
+	}
+
+	length := elements.Len()
+	count := 0
+	if recv != nil && take && !fromRight {
+		// only the leading elements were received
+		count = length
+	}
+	for count < length {
+		index := count
+		if fromRight {
+			index = length - 1 - count
+		}
+		if !leading(elements.Index(index), count) {
+			break
+		}
+		count++
+	}
+
+	start, end := 0, length
+	switch {
+	case take && !fromRight:
+		end = count
+	case take && fromRight:
+		start = length - count
+	case !take && !fromRight:
+		start = count
+	default:
+		end = length - count
+	}
+
+	part := elements.Slice(start, end)
+	if isString {
+		output.Elem().Set(reflect.ValueOf(string(part.Interface().([]rune))).Convert(output.Elem().Type()))
+		return nil
+	}
+	result := reflect.MakeSlice(output.Elem().Type(), part.Len(), part.Len())
+	reflect.Copy(result, part)
+	output.Elem().Set(result)
+
+	return nil
+}
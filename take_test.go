@@ -0,0 +1,206 @@
+package godash_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestTake(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5}
+
+	t.Run("should take and drop elements by count", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			fn       func(in, out interface{}, n int) error
+			n        int
+			expected []int
+		}{
+			{"Take", godash.Take, 2, []int{1, 2}},
+			{"Take", godash.Take, 10, []int{1, 2, 3, 4, 5}},
+			{"Take", godash.Take, -1, []int{}},
+			{"TakeRight", godash.TakeRight, 2, []int{4, 5}},
+			{"Drop", godash.Drop, 2, []int{3, 4, 5}},
+			{"Drop", godash.Drop, 10, []int{}},
+			{"DropRight", godash.DropRight, 2, []int{1, 2, 3}},
+			{"DropRight", godash.DropRight, 0, []int{1, 2, 3, 4, 5}},
+		}
+
+		for _, c := range cases {
+			var out []int
+
+			err := c.fn(in, &out, c.n)
+
+			assert.NoError(t, err, c.name)
+			assert.Equal(t, c.expected, out, "%s(%d)", c.name, c.n)
+		}
+	})
+
+	t.Run("should take and drop elements by predicate", func(t *testing.T) {
+		lessThan3 := func(n int) bool { return n < 3 }
+		moreThan3 := func(n int) bool { return n > 3 }
+
+		cases := []struct {
+			name      string
+			fn        func(in, out, predicateFn interface{}) error
+			predicate func(int) bool
+			expected  []int
+		}{
+			{"TakeWhile", godash.TakeWhile, lessThan3, []int{1, 2}},
+			{"TakeWhile", godash.TakeWhile, moreThan3, []int{}},
+			{"TakeRightWhile", godash.TakeRightWhile, moreThan3, []int{4, 5}},
+			{"DropWhile", godash.DropWhile, lessThan3, []int{3, 4, 5}},
+			{"DropRightWhile", godash.DropRightWhile, moreThan3, []int{1, 2, 3}},
+			{"DropRightWhile", godash.DropRightWhile, lessThan3, []int{1, 2, 3, 4, 5}},
+		}
+
+		for _, c := range cases {
+			var out []int
+
+			err := c.fn(in, &out, c.predicate)
+
+			assert.NoError(t, err, c.name)
+			assert.Equal(t, c.expected, out, c.name)
+		}
+	})
+
+	t.Run("should not share the backing array with input", func(t *testing.T) {
+		input := []int{1, 2, 3}
+		var out []int
+
+		_ = godash.Take(input, &out, 2)
+		out[0] = 10
+
+		assert.Equal(t, []int{1, 2, 3}, input)
+	})
+
+	t.Run("should support arrays", func(t *testing.T) {
+		var out []int
+
+		err := godash.DropRight([3]int{1, 2, 3}, &out, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, out)
+	})
+
+	t.Run("should take and drop runes of strings", func(t *testing.T) {
+		var out string
+		{
+			err := godash.Take("héllo", &out, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, "hé", out)
+		}
+		{
+			err := godash.DropWhile("   padded", &out, func(r rune) bool { return r == ' ' })
+			assert.NoError(t, err)
+			assert.Equal(t, "padded", out)
+		}
+	})
+
+	t.Run("should receive only the taken elements from channels", func(t *testing.T) {
+		input := make(chan int, 5)
+		for _, n := range in {
+			input <- n
+		}
+		close(input)
+		var first, next, rest []int
+
+		assert.NoError(t, godash.Take(input, &first, 2))
+		assert.NoError(t, godash.TakeWhile(input, &next, func(n int) bool { return n < 4 }))
+		assert.NoError(t, godash.Drop(input, &rest, 0))
+
+		assert.Equal(t, []int{1, 2}, first)
+		assert.Equal(t, []int{3}, next)
+		assert.Equal(t, []int{5}, rest)
+	})
+
+	t.Run("should receive from channels until closed when needed", func(t *testing.T) {
+		input := make(chan int, 5)
+		for _, n := range in {
+			input <- n
+		}
+		close(input)
+		var out []int
+
+		err := godash.TakeRight(input, &out, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 5}, out)
+	})
+
+	t.Run("should support matchers as predicate", func(t *testing.T) {
+		type task struct {
+			Done bool
+		}
+		tasks := []task{{Done: true}, {Done: false}, {Done: true}}
+		var out []task
+
+		err := godash.TakeWhile(tasks, &out, godash.MatchesProperty("Done", true))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []task{{Done: true}}, out)
+	})
+
+	t.Run("should validate input, output and predicate", func(t *testing.T) {
+		var out []int
+		{
+			err := godash.Take(map[int]int{}, &out, 1)
+			assert.EqualError(t, err, "not implemented for (map)")
+		}
+		{
+			var out []string
+			err := godash.Take(in, &out, 1)
+			assert.EqualError(t, err, "output ([]string) should be a slice of (int)")
+		}
+		{
+			err := godash.Take("abc", &out, 1)
+			assert.EqualError(t, err, "output ([]int) should be a string for input of type string")
+		}
+		{
+			err := godash.Take(make(chan<- int), &out, 1)
+			assert.EqualError(t, err, "input channel (chan<- int) has to allow receiving")
+		}
+		{
+			err := godash.TakeWhile(in, &out, nil)
+			assert.EqualError(t, err, "predicateFn has to be a function")
+		}
+		{
+			err := godash.DropWhile(in, &out, func(int, int) bool { return true })
+			assert.EqualError(t, err, "predicate function has to take only one argument")
+		}
+		{
+			err := godash.DropWhile(in, &out, func(int) int { return 0 })
+			assert.EqualError(t, err, "predicate function should return only a (boolean) and not a (int)")
+		}
+		{
+			err := godash.DropWhile(in, &out, func(string) bool { return true })
+			assert.EqualError(t, err, "predicate function's first argument has to be the type (int) instead of (string)")
+		}
+	})
+}
+
+func ExampleTakeWhile() {
+	input := []int{1, 2, 3, 4, 1}
+	var output []int
+
+	_ = godash.TakeWhile(input, &output, func(num int) bool {
+		return num < 3
+	})
+
+	fmt.Println(output)
+
+	// Output: [1 2]
+}
+
+func ExampleDrop() {
+	input := []string{"a", "b", "c"}
+	var output []string
+
+	_ = godash.Drop(input, &output, 1)
+
+	fmt.Println(output)
+
+	// Output: [b c]
+}
@@ -0,0 +1,123 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Times calls fn n times, with the index of the call from 0 to n-1, and sets out to the results.
+// A negative n is treated as zero.
+//
+// Validations:
+//
+//	1. Fn should be a function that takes an int and returns one value
+//	2. Output should be a reference to a slice of the type fn returns
+//
+// Validation errors are returned to the caller.
+func Times(n int, fn, out interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	calls, err := timesOf(n, fn)
+	if err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Slice || output.Elem().Type().Elem() != calls.elemType {
+		return fmt.Errorf("output (%s) should be a slice of (%s)", output.Elem().Type(), calls.elemType)
+	}
+	calls.collect(output.Elem())
+
+	return nil
+}
+
+// TimesIterator is the lazy form of Times. It returns an Iterator of the results of calling fn n times,
+// where fn is called only as the elements are read. A negative n makes the Iterator endless.
+// It is validated like Times.
+func TimesIterator(n int, fn interface{}) (Iterator, error) {
+	return timesOf(n, fn)
+}
+
+func timesOf(n int, fn interface{}) (*generator, error) {
+	function := reflect.ValueOf(fn)
+	if function.Kind() != reflect.Func {
+		return nil, fmt.Errorf("fn has to be a function")
+	}
+	fnType := function.Type()
+	if intType := reflect.TypeOf(0); fnType.NumIn() != 1 || fnType.In(0) != intType {
+		return nil, fmt.Errorf("fn has to take only one argument of type (%s)", intType)
+	}
+	if fnType.NumOut() != 1 {
+		return nil, fmt.Errorf("fn should return only one value")
+	}
+
+	return &generator{elemType: fnType.Out(0), count: int64(n), at: func(index int64) reflect.Value {
+		return function.Call([]reflect.Value{reflect.ValueOf(int(index))})[0]
+	}}, nil
+}
+
+// Repeat sets out to n copies of value. A negative n is treated as zero.
+// If out is a reference to a string, value should be a string and out is set to it repeated n times.
+//
+// Validations:
+//
+//	1. Output should be a reference to a slice or a string
+//	2. Value should be assignable to the element type of output
+//
+// Validation errors are returned to the caller.
+func Repeat(value interface{}, n int, out interface{}) error {
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	element := reflect.ValueOf(value)
+	switch output.Elem().Kind() {
+	case reflect.String:
+		if element.Kind() != reflect.String {
+			return fmt.Errorf("value (%v) has to be a string for output of type (%s)", value, output.Elem().Type())
+		}
+		output.Elem().SetString(strings.Repeat(element.String(), n))
+		return nil
+	case reflect.Slice:
+	default:
+		return fmt.Errorf("output (%s) should be a slice or a string", output.Elem().Type())
+	}
+
+	elemType := output.Elem().Type().Elem()
+	switch {
+	case !element.IsValid() && isNillable(elemType.Kind()):
+		element = reflect.Zero(elemType)
+	case !element.IsValid() || !element.Type().AssignableTo(elemType):
+		return fmt.Errorf("value (%v) cannot be repeated in (%s)", value, output.Elem().Type())
+	}
+
+	copies := &generator{elemType: elemType, count: int64(n), at: func(int64) reflect.Value {
+		return element
+	}}
+	copies.collect(output.Elem())
+
+	return nil
+}
+
+// RepeatIterator is the lazy form of Repeat. It returns an Iterator of n copies of value,
+// which are of the type of value, or of type interface{} if value is nil. A negative n makes the Iterator endless.
+func RepeatIterator(value interface{}, n int) Iterator {
+	elemType := interfaceType
+	element := reflect.Zero(interfaceType)
+	if value != nil {
+		element = reflect.ValueOf(value)
+		elemType = element.Type()
+	}
+	return &generator{elemType: elemType, count: int64(n), at: func(int64) reflect.Value {
+		return element
+	}}
+}
@@ -0,0 +1,179 @@
+package godash_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+func TestTimes(t *testing.T) {
+	square := func(i int) int { return i * i }
+
+	t.Run("should set the results of calling fn with each index", func(t *testing.T) {
+		var out []int
+		err := godash.Times(4, square, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 4, 9}, out)
+	})
+
+	t.Run("should treat a negative n as zero", func(t *testing.T) {
+		var out []int
+		err := godash.Times(-1, square, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, out)
+	})
+
+	t.Run("should validate fn and output", func(t *testing.T) {
+		var out []int
+		err := godash.Times(2, "fn", &out)
+		assert.EqualError(t, err, "fn has to be a function")
+
+		err = godash.Times(2, func(i int64) int { return 0 }, &out)
+		assert.EqualError(t, err, "fn has to take only one argument of type (int)")
+
+		err = godash.Times(2, func(int) {}, &out)
+		assert.EqualError(t, err, "fn should return only one value")
+
+		var strs []string
+		err = godash.Times(2, square, &strs)
+		assert.EqualError(t, err, "output ([]string) should be a slice of (int)")
+
+		err = godash.Times(2, square, out)
+		assert.EqualError(t, err, "output is nil. Pass a reference to set output")
+	})
+}
+
+func TestTimesIterator(t *testing.T) {
+	t.Run("should call fn only as elements are read", func(t *testing.T) {
+		calls := 0
+		squares, err := godash.TimesIterator(-1, func(i int) int {
+			calls++
+			return i * i
+		})
+		assert.NoError(t, err)
+
+		var out []int
+		err = godash.TakeWhile(squares, &out, func(n int) bool { return n < 10 })
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 4, 9}, out)
+		assert.Equal(t, 5, calls)
+	})
+
+	t.Run("should end after n elements", func(t *testing.T) {
+		squares, err := godash.TimesIterator(3, func(i int) int { return i * i })
+		assert.NoError(t, err)
+
+		var out []int
+		err = godash.Collect(squares, &out)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{0, 1, 4}, out)
+	})
+
+	t.Run("should validate fn", func(t *testing.T) {
+		_, err := godash.TimesIterator(3, func() int { return 0 })
+		assert.EqualError(t, err, "fn has to take only one argument of type (int)")
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	t.Run("should set n copies of value", func(t *testing.T) {
+		var out []string
+		err := godash.Repeat("go", 3, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"go", "go", "go"}, out)
+	})
+
+	t.Run("should repeat a string for string output", func(t *testing.T) {
+		var out string
+		err := godash.Repeat("ab", 3, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ababab", out)
+	})
+
+	t.Run("should repeat values assignable to the output's element type", func(t *testing.T) {
+		var values []interface{}
+		err := godash.Repeat(1, 2, &values)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{1, 1}, values)
+
+		var errs []error
+		err = godash.Repeat(nil, 2, &errs)
+		assert.NoError(t, err)
+		assert.Equal(t, []error{nil, nil}, errs)
+	})
+
+	t.Run("should treat a negative n as zero", func(t *testing.T) {
+		var out []int
+		err := godash.Repeat(1, -2, &out)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{}, out)
+	})
+
+	t.Run("should validate value and output", func(t *testing.T) {
+		var out []int
+		err := godash.Repeat("1", 2, &out)
+		assert.EqualError(t, err, "value (1) cannot be repeated in ([]int)")
+
+		err = godash.Repeat(nil, 2, &out)
+		assert.EqualError(t, err, "value (<nil>) cannot be repeated in ([]int)")
+
+		var str string
+		err = godash.Repeat(1, 2, &str)
+		assert.EqualError(t, err, "value (1) has to be a string for output of type (string)")
+
+		var notSlice int
+		err = godash.Repeat(1, 2, &notSlice)
+		assert.EqualError(t, err, "output (int) should be a slice or a string")
+	})
+}
+
+func TestRepeatIterator(t *testing.T) {
+	t.Run("should repeat value endlessly for a negative n", func(t *testing.T) {
+		var out []string
+		err := godash.Take(godash.RepeatIterator("go", -1), &out, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"go", "go", "go", "go"}, out)
+	})
+
+	t.Run("should repeat value n times", func(t *testing.T) {
+		var out []string
+		err := godash.Map(godash.RepeatIterator("go", 2), &out, strings.ToUpper)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"GO", "GO"}, out)
+	})
+}
+
+func ExampleTimes() {
+	var labels []string
+	_ = godash.Times(3, func(i int) string {
+		return fmt.Sprintf("item-%d", i)
+	}, &labels)
+
+	fmt.Println(labels)
+
+	// Output: [item-0 item-1 item-2]
+}
+
+func ExampleRepeat() {
+	var zeros []int
+	var line string
+	_ = godash.Repeat(0, 3, &zeros)
+	_ = godash.Repeat("=", 10, &line)
+
+	fmt.Println(zeros)
+	fmt.Println(line)
+
+	// Output:
+	// [0 0 0]
+	// ==========
+}
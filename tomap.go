@@ -0,0 +1,365 @@
+package godash
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// ToMap converts the struct in, or a pointer to it, to a map the way encoding/json would lay it out.
+//
+// Keys are taken from the json tag of fields, or the field name if there is no tag.
+// Fields tagged with `json:"-"` are skipped and fields tagged with omitempty are skipped if they are zero.
+// Fields of embedded structs are added to the map as if they were fields of in, unless they are shadowed
+// by fields with the same key in in or in a less deeply embedded struct, as encoding/json does.
+// Nested structs, and pointers to them, are converted to maps as well except types that implement
+// json.Marshaler or encoding.TextMarshaler (like time.Time) which are kept as is.
+// Nil pointers are put as nil and other pointers are dereferenced.
+//
+// Validations:
+//
+//	1. In should be a struct or a pointer to a struct
+//
+// Validation errors are returned to the caller.
+func ToMap(in interface{}) (map[string]interface{}, error) {
+	input := indirect(reflect.ValueOf(in))
+	if input.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("input has to be a struct and not (%s)", reflect.ValueOf(in).Kind())
+	}
+
+	return structToMap(input), nil
+}
+
+// FromMap sets the fields of the struct out points to from the values in the map in.
+// Keys are matched with fields the same way ToMap lays them out, ie. by json tag or field name,
+// with fields of embedded structs matched as if they were fields of the outer struct.
+//
+// Keys that do not match a field are ignored and fields without a key are left untouched.
+// Maps are converted to nested structs, or pointers to them, and numbers are converted to the numeric type of the field
+// if they fit in it without overflowing or losing a fraction.
+// Slices and maps of other element types, like the []interface{} and map[string]interface{} encoding/json decodes to,
+// are converted element by element, so the output of json.Unmarshal into an interface{} can be set.
+//
+// Validations:
+//
+//	1. In should be a map with string keys
+//	2. Out should be a reference to a struct
+//	3. Values should be assignable to their fields
+//
+// Type mismatches are returned as *PathError and validation errors are returned to the caller.
+func FromMap(in, out interface{}) error {
+	input := reflect.ValueOf(in)
+	if input.Kind() != reflect.Map || input.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("input has to be a map with string keys")
+	}
+
+	output := reflect.ValueOf(out)
+	if err := validateOut(output); err != nil {
+		return err
+	}
+	if output.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("output has to be a reference to a struct and not (%s)", output.Elem().Type())
+	}
+
+	return mapToStruct(input, output.Elem(), "")
+}
+
+// structEntry is a field of a struct along with its key as per its json tag.
+type structEntry struct {
+	key       string
+	field     reflect.StructField
+	value     reflect.Value
+	omitEmpty bool
+}
+
+// jsonKey returns the key in the json tag of field and whether it has omitempty.
+func jsonKey(field reflect.StructField) (key string, omitEmpty bool, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, false
+}
+
+// jsonField is an exported field of a struct type, or of a struct embedded in it, along with its key.
+type jsonField struct {
+	key       string
+	field     reflect.StructField
+	index     []int
+	omitEmpty bool
+	tagged    bool
+}
+
+// jsonFields returns the exported fields of the struct type t the way encoding/json lays them out.
+// Fields of embedded structs are flattened, and a field shadows the fields with the same key in deeper embedded structs.
+// Of fields with the same key at the same depth, the one with a json tag wins, and all are dropped if that does not decide.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	collectJSONFields(t, nil, map[reflect.Type]bool{}, &fields)
+
+	byKey := map[string][]int{}
+	for i, f := range fields {
+		byKey[f.key] = append(byKey[f.key], i)
+	}
+
+	var result []jsonField
+	for i, f := range fields {
+		if dominantField(fields, byKey[f.key]) == i {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+func collectJSONFields(t reflect.Type, index []int, embedding map[reflect.Type]bool, fields *[]jsonField) {
+	embedding[t] = true
+	defer delete(embedding, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key, omitEmpty, skip := jsonKey(field)
+		if skip {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+
+		if field.Anonymous && key == "" {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+			if embeddedType.Kind() == reflect.Struct {
+				if !embedding[embeddedType] {
+					collectJSONFields(embeddedType, fieldIndex, embedding, fields)
+				}
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+		tagged := key != ""
+		if !tagged {
+			key = field.Name
+		}
+		*fields = append(*fields, jsonField{key: key, field: field, index: fieldIndex, omitEmpty: omitEmpty, tagged: tagged})
+	}
+}
+
+// dominantField returns which of the fields at positions, all with the same key, is laid out, or -1 if none is.
+func dominantField(fields []jsonField, positions []int) int {
+	depth := -1
+	var shallowest []int
+	for _, position := range positions {
+		switch d := len(fields[position].index); {
+		case depth < 0 || d < depth:
+			depth, shallowest = d, []int{position}
+		case d == depth:
+			shallowest = append(shallowest, position)
+		}
+	}
+	if len(shallowest) == 1 {
+		return shallowest[0]
+	}
+
+	dominant := -1
+	for _, position := range shallowest {
+		if fields[position].tagged {
+			if dominant >= 0 {
+				return -1
+			}
+			dominant = position
+		}
+	}
+	return dominant
+}
+
+// structEntries returns the exported fields of the struct v as laid out by jsonFields.
+// Fields of embedded structs behind nil pointers are skipped.
+func structEntries(v reflect.Value) []structEntry {
+	var entries []structEntry
+	for _, f := range jsonFields(v.Type()) {
+		value, ok := fieldByIndex(v, f.index)
+		if !ok || !value.CanInterface() {
+			continue
+		}
+		entries = append(entries, structEntry{key: f.key, field: f.field, value: value, omitEmpty: f.omitEmpty})
+	}
+	return entries
+}
+
+// settableFieldByIndex returns the field of the settable struct v at index, allocating embedded structs
+// behind nil pointers on the way, and false if the field cannot be set.
+func settableFieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(fieldIndex)
+	}
+	return v, v.CanSet()
+}
+
+func structToMap(v reflect.Value) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, entry := range structEntries(v) {
+		if entry.omitEmpty && entry.value.IsZero() {
+			continue
+		}
+		result[entry.key] = toMapValue(entry.value)
+	}
+	return result
+}
+
+func toMapValue(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		if v.Elem().Kind() == reflect.Struct && !keptAsIs(v.Type()) {
+			return toMapValue(v.Elem())
+		}
+	}
+	if v.Kind() == reflect.Struct && !keptAsIs(v.Type()) {
+		return structToMap(v)
+	}
+	return v.Interface()
+}
+
+func keptAsIs(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) ||
+		reflect.PtrTo(t).Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)
+}
+
+// mapToStruct sets the fields of the settable struct out from the map in.
+func mapToStruct(in, out reflect.Value, path string) error {
+	for _, f := range jsonFields(out.Type()) {
+		value := in.MapIndex(reflect.ValueOf(f.key).Convert(in.Type().Key()))
+		if !value.IsValid() {
+			continue
+		}
+		fieldValue, ok := settableFieldByIndex(out, f.index)
+		if !ok {
+			continue
+		}
+		if err := setFromMapValue(fieldValue, value, joinPath(path, f.key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setFromMapValue(field, value reflect.Value, path string) error {
+	if value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	structType := field.Type()
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	isNestedMap := value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String
+	if structType.Kind() == reflect.Struct && isNestedMap {
+		target := field
+		if field.Kind() == reflect.Ptr {
+			target = reflect.New(structType)
+			if !field.IsNil() {
+				target.Elem().Set(field.Elem())
+			}
+			field.Set(target)
+			target = target.Elem()
+		}
+		return mapToStruct(value, target, path)
+	}
+
+	if !value.Type().AssignableTo(field.Type()) {
+		switch {
+		case isListKind(field.Kind()) && isListKind(value.Kind()):
+			return setFromListValue(field, value, path)
+		case field.Kind() == reflect.Map && value.Kind() == reflect.Map:
+			return setFromMapEntries(field, value, path)
+		}
+	}
+
+	result, err := assignable(value, field.Type(), path)
+	if err != nil {
+		return err
+	}
+	field.Set(result)
+	return nil
+}
+
+func isListKind(kind reflect.Kind) bool {
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// setFromListValue sets the slice or array field from the elements of the slice or array value.
+// Like encoding/json does, extra elements are dropped and missing ones are zeroed for arrays.
+func setFromListValue(field, value reflect.Value, path string) error {
+	if value.Kind() == reflect.Slice && value.IsNil() {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	result := reflect.New(field.Type()).Elem()
+	if field.Kind() == reflect.Slice {
+		result = reflect.MakeSlice(field.Type(), value.Len(), value.Len())
+	}
+	for i := 0; i < result.Len() && i < value.Len(); i++ {
+		if err := setFromMapValue(result.Index(i), value.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	field.Set(result)
+	return nil
+}
+
+// setFromMapEntries sets the map field from the entries of the map value.
+func setFromMapEntries(field, value reflect.Value, path string) error {
+	if value.IsNil() {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	mapType := field.Type()
+	result := reflect.MakeMapWithSize(mapType, value.Len())
+	for _, key := range value.MapKeys() {
+		entryPath := joinPath(path, fmt.Sprint(key))
+		resultKey, err := assignable(key, mapType.Key(), entryPath)
+		if err != nil {
+			return err
+		}
+		resultValue := reflect.New(mapType.Elem()).Elem()
+		if err := setFromMapValue(resultValue, value.MapIndex(key), entryPath); err != nil {
+			return err
+		}
+		result.SetMapIndex(resultKey, resultValue)
+	}
+	field.Set(result)
+	return nil
+}
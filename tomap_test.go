@@ -0,0 +1,241 @@
+package godash_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thecasualcoder/godash"
+)
+
+type audit struct {
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type owner struct {
+	Name string `json:"name"`
+}
+
+type account struct {
+	audit
+	ID       int               `json:"id"`
+	Email    string            `json:"email,omitempty"`
+	Password string            `json:"-"`
+	Owner    *owner            `json:"owner"`
+	Backup   *owner            `json:"backup"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Active   bool
+	internal string
+}
+
+type base struct {
+	Name string
+	Kind string
+	ID   int `json:"id"`
+}
+
+type extra struct {
+	Kind   string `json:"Kind"`
+	Serial int    `json:"id"`
+}
+
+type layered struct {
+	base
+	*extra
+	Name string
+}
+
+func TestToMap(t *testing.T) {
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should convert structs honoring json tags, embedded structs and pointers", func(t *testing.T) {
+		in := account{
+			audit:    audit{CreatedBy: "admin", CreatedAt: createdAt},
+			ID:       1,
+			Password: "secret",
+			Owner:    &owner{Name: "John"},
+			Active:   true,
+			internal: "internal",
+		}
+
+		out, err := godash.ToMap(&in)
+
+		expected := map[string]interface{}{
+			"created_by": "admin",
+			"created_at": createdAt,
+			"id":         1,
+			"owner":      map[string]interface{}{"name": "John"},
+			"backup":     nil,
+			"Active":     true,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, out)
+	})
+
+	t.Run("should shadow embedded fields as encoding/json does", func(t *testing.T) {
+		in := layered{base: base{Name: "inner", Kind: "base", ID: 1}, extra: &extra{Kind: "extra", Serial: 2}, Name: "outer"}
+
+		out, err := godash.ToMap(in)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"Name": "outer", "Kind": "extra"}, out)
+	})
+
+	t.Run("should validate input", func(t *testing.T) {
+		_, err := godash.ToMap([]int{})
+
+		assert.EqualError(t, err, "input has to be a struct and not (slice)")
+	})
+}
+
+func TestFromMap(t *testing.T) {
+	t.Run("should set fields honoring json tags, embedded structs and pointers", func(t *testing.T) {
+		in := map[string]interface{}{
+			"created_by": "admin",
+			"id":         float64(1),
+			"email":      "john@example.com",
+			"Password":   "ignored",
+			"owner":      map[string]interface{}{"name": "John"},
+			"backup":     nil,
+			"labels":     map[string]string{"env": "prod"},
+			"Active":     true,
+			"unknown":    "ignored",
+		}
+		out := account{Backup: &owner{}, Password: "kept"}
+
+		err := godash.FromMap(in, &out)
+
+		expected := account{
+			audit:    audit{CreatedBy: "admin"},
+			ID:       1,
+			Email:    "john@example.com",
+			Password: "kept",
+			Owner:    &owner{Name: "John"},
+			Labels:   map[string]string{"env": "prod"},
+			Active:   true,
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, out)
+	})
+
+	t.Run("should set only the fields not shadowed as encoding/json does", func(t *testing.T) {
+		out := layered{extra: &extra{}}
+
+		err := godash.FromMap(map[string]interface{}{"Name": "outer", "Kind": "extra", "id": 1}, &out)
+
+		expected := layered{extra: &extra{Kind: "extra"}, Name: "outer"}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, out)
+	})
+
+	t.Run("should convert slices and maps decoded from json element by element", func(t *testing.T) {
+		type team struct {
+			Tags     []string         `json:"tags"`
+			Members  []owner          `json:"members"`
+			Leads    []*owner         `json:"leads"`
+			Quotas   map[string]int   `json:"quotas"`
+			Owners   map[string]owner `json:"owners"`
+			Position [2]float32       `json:"position"`
+			Matrix   [][]int          `json:"matrix"`
+			Empty    []string         `json:"empty"`
+		}
+		var in map[string]interface{}
+		decoded := `{
+			"tags": ["a", "b"],
+			"members": [{"name": "John"}, {"name": "Jane"}],
+			"leads": [{"name": "John"}, null],
+			"quotas": {"cpu": 2},
+			"owners": {"api": {"name": "Jane"}},
+			"position": [1.5, 2.5, 3.5],
+			"matrix": [[1], [2, 3]],
+			"empty": null
+		}`
+		assert.NoError(t, json.Unmarshal([]byte(decoded), &in))
+		out := team{Empty: []string{"kept"}}
+
+		err := godash.FromMap(in, &out)
+
+		expected := team{
+			Tags:     []string{"a", "b"},
+			Members:  []owner{{Name: "John"}, {Name: "Jane"}},
+			Leads:    []*owner{{Name: "John"}, nil},
+			Quotas:   map[string]int{"cpu": 2},
+			Owners:   map[string]owner{"api": {Name: "Jane"}},
+			Position: [2]float32{1.5, 2.5},
+			Matrix:   [][]int{{1}, {2, 3}},
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, out)
+	})
+
+	t.Run("should return path errors on type mismatches", func(t *testing.T) {
+		var out account
+
+		err := godash.FromMap(map[string]interface{}{"owner": map[string]interface{}{"name": 1}}, &out)
+		assert.EqualError(t, err, "path (owner.name) at (name): value of type (int) cannot be assigned to (string)")
+
+		err = godash.FromMap(map[string]interface{}{"id": 1.5}, &out)
+		assert.EqualError(t, err, "path (id) at (id): value (1.5) cannot be converted to (int) exactly")
+
+		err = godash.FromMap(map[string]interface{}{"labels": map[string]interface{}{"env": true}}, &out)
+		assert.EqualError(t, err, "path (labels.env) at (env): value of type (bool) cannot be assigned to (string)")
+
+		var team struct {
+			Members []owner
+		}
+		err = godash.FromMap(map[string]interface{}{"Members": []interface{}{map[string]interface{}{"name": 1}}}, &team)
+		assert.EqualError(t, err, "path (Members[0].name) at (name): value of type (int) cannot be assigned to (string)")
+
+		err = godash.FromMap(map[string]interface{}{"Members": []interface{}{"John"}}, &team)
+		assert.EqualError(t, err, "path (Members[0]) at ([0]): value of type (string) cannot be assigned to (godash_test.owner)")
+	})
+
+	t.Run("should validate input and output", func(t *testing.T) {
+		var out account
+
+		{
+			err := godash.FromMap(map[int]string{}, &out)
+			assert.EqualError(t, err, "input has to be a map with string keys")
+		}
+		{
+			err := godash.FromMap(map[string]string{}, out)
+			assert.EqualError(t, err, "cannot set out. Pass a reference to set output")
+		}
+		{
+			var out map[string]string
+			err := godash.FromMap(map[string]string{}, &out)
+			assert.EqualError(t, err, "output has to be a reference to a struct and not (map[string]string)")
+		}
+	})
+}
+
+func ExampleToMap() {
+	type person struct {
+		Name  string `json:"name"`
+		Email string `json:"email,omitempty"`
+		Age   int
+	}
+
+	output, _ := godash.ToMap(person{Name: "John", Age: 22})
+
+	fmt.Println(output)
+
+	// Output: map[Age:22 name:John]
+}
+
+func ExampleFromMap() {
+	type person struct {
+		Name string `json:"name"`
+		Age  int
+	}
+	var output person
+
+	_ = godash.FromMap(map[string]interface{}{"name": "John", "Age": 22.0}, &output)
+
+	fmt.Println(output)
+
+	// Output: {John 22}
+}